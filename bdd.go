@@ -0,0 +1,51 @@
+package got
+
+import "testing"
+
+// Describe groups the specs of subject in BDD style. Within fn, the specs
+// added with It are labeled with the subject, as in "Calculator: adds two
+// numbers". fn receives r itself; nested Describe calls join their subjects.
+//
+// Parameters:
+//   - subject: The thing being described
+//   - fn: The function declaring the specs with It
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Describe("Calculator", func(d *got.R) {
+//		d.It("adds two numbers", func(tt *testing.T) {
+//			d.AssertEqual(5, Add(2, 3))
+//		})
+//	})
+func (r *R) Describe(subject string, fn func(d *R)) *R {
+	prev := r.subject
+	if prev != "" {
+		subject = prev + " " + subject
+	}
+	r.subject = subject
+	defer func() { r.subject = prev }()
+	fn(r)
+	return r
+}
+
+// It runs a spec describing one behavior of the current Describe subject. It
+// starts a case and runs fn as a subtest, both labeled "subject: behavior".
+//
+// Parameters:
+//   - behavior: The expected behavior, such as "adds two numbers"
+//   - fn: The test function of the spec
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+func (r *R) It(behavior string, fn func(tt *testing.T)) *R {
+	r.T.Helper()
+	label := behavior
+	if r.subject != "" {
+		label = r.subject + ": " + behavior
+	}
+	r.Case(label)
+	return r.Run(label, fn)
+}
@@ -0,0 +1,34 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// TestDescribe tests the BDD-style Describe and It wrappers
+func TestDescribe(t *testing.T) {
+	r := got.New(t, "Test Describe")
+
+	var names []string
+	r.Describe("Calculator", func(d *got.R) {
+		d.It("adds two numbers", func(tt *testing.T) {
+			names = append(names, tt.Name())
+			d.AssertEqual(5, 2+3)
+		})
+		d.Describe("division", func(d *got.R) {
+			d.It("rejects zero", func(tt *testing.T) {
+				names = append(names, tt.Name())
+			})
+		})
+	})
+	r.It("runs without a subject", func(tt *testing.T) {
+		names = append(names, tt.Name())
+	})
+
+	r.AssertEqual([]string{
+		"TestDescribe/Calculator:_adds_two_numbers",
+		"TestDescribe/Calculator_division:_rejects_zero",
+		"TestDescribe/runs_without_a_subject",
+	}, names)
+}
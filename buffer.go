@@ -0,0 +1,156 @@
+package got
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// logBuffer collects the log lines of a buffered runner until they are flushed.
+type logBuffer struct {
+	mu    sync.Mutex
+	lines []string
+	quiet bool // discard the lines at the end of a passing test (see WithFailureContext)
+}
+
+// SetBuffered enables or disables buffering of the runner's output.
+// While buffering is enabled, the lines logged by Case, Pass, Fail and the
+// Log/Logf/Errorf methods are collected instead of being written immediately,
+// and they are written with a single t.Log call when the test ends or when
+// Flush is called. This keeps each runner's output contiguous when tests run
+// in parallel. Failures still mark the test as failed immediately.
+// Disabling buffering flushes any pending output.
+//
+// Parameters:
+//   - on: Whether to buffer the runner's output
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r := got.New(t, "Parallel Suite").SetBuffered(true)
+//	r.Parallel()
+//	r.Case("logs stay together")
+func (r *R) SetBuffered(on bool) *R {
+	root := r.root()
+	switch {
+	case on && root.buf == nil:
+		root.buf = &logBuffer{}
+		root.T.Cleanup(root.flushAtEnd)
+	case !on && root.buf != nil:
+		root.Flush()
+		root.buf = nil
+	}
+	return r
+}
+
+// Flush writes the buffered output of the runner, if any, with a single
+// t.Log call. It does nothing if buffering is disabled.
+func (r *R) Flush() {
+	r.T.Helper()
+	buf := r.root().buf
+	if buf == nil {
+		return
+	}
+	buf.mu.Lock()
+	lines := buf.lines
+	buf.lines = nil
+	buf.mu.Unlock()
+	if len(lines) > 0 {
+		r.T.Log(strings.Join(lines, "\n"))
+	}
+}
+
+// flushAtEnd writes the buffered output when the test ends. In failure
+// context mode, the output of a passing test is discarded instead.
+func (r *R) flushAtEnd() {
+	buf := r.root().buf
+	if buf == nil {
+		return
+	}
+	if buf.quiet && !r.T.Failed() {
+		buf.mu.Lock()
+		buf.lines = nil
+		buf.mu.Unlock()
+		return
+	}
+	r.Flush()
+}
+
+// buffer appends line to the runner's buffer and reports whether buffering
+// is enabled.
+func (r *R) buffer(line string) bool {
+	buf := r.root().buf
+	if buf == nil {
+		return false
+	}
+	buf.mu.Lock()
+	buf.lines = append(buf.lines, line)
+	buf.mu.Unlock()
+	return true
+}
+
+// Log formats its arguments like testing.T.Log, buffering the line if the
+// runner is buffered. The line is also written to the reporter, if any
+// (see WithReporter). Lines logged by the runner of a row of Cases run with
+// Ordered are held until the row's output is written.
+func (r *R) Log(args ...any) {
+	r.T.Helper()
+	line := strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+	if r.hold(line) {
+		return
+	}
+	r.forward(line)
+	if !r.buffer(line) {
+		r.T.Log(args...)
+	}
+}
+
+// Logf formats its arguments like testing.T.Logf, buffering the line if the
+// runner is buffered. The line is also written to the reporter, if any.
+func (r *R) Logf(format string, args ...any) {
+	r.T.Helper()
+	line := fmt.Sprintf(format, args...)
+	if r.hold(line) {
+		return
+	}
+	r.forward(line)
+	if !r.buffer(line) {
+		r.T.Logf(format, args...)
+	}
+}
+
+// Errorf is equivalent to Logf followed by testing.T.Fail.
+func (r *R) Errorf(format string, args ...any) {
+	r.T.Helper()
+	line := fmt.Sprintf(format, args...)
+	if r.hold(line) {
+		r.T.Fail()
+		return
+	}
+	r.forward(line)
+	if r.buffer(line) {
+		r.T.Fail()
+	} else {
+		r.T.Errorf(format, args...)
+	}
+}
+
+// Fatalf is equivalent to Logf followed by testing.T.FailNow. Buffered output
+// is flushed before the test stops.
+func (r *R) Fatalf(format string, args ...any) {
+	r.T.Helper()
+	line := fmt.Sprintf(format, args...)
+	if r.hold(line) {
+		r.T.FailNow()
+		return
+	}
+	r.forward(line)
+	if r.buffer(line) {
+		r.Flush()
+		r.T.FailNow()
+	} else {
+		r.T.Fatalf(format, args...)
+	}
+}
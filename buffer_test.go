@@ -0,0 +1,105 @@
+package got
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestSetBuffered tests buffering and flushing of the runner output
+func TestSetBuffered(t *testing.T) {
+	r := New(t, "Test SetBuffered").SetBuffered(true)
+
+	r.Case("Testing lines are buffered")
+	r.Pass("buffered pass")
+	r.Not().Pass("buffered pass from a Not view")
+	r.Log("buffered", "log")
+	if n := len(r.buf.lines); n != 4 {
+		t.Errorf("expected 4 buffered lines, got %d", n)
+	}
+
+	r.Flush()
+	if n := len(r.buf.lines); n != 0 {
+		t.Errorf("expected no buffered lines after Flush, got %d", n)
+	}
+
+	r.Case("Testing disabling buffering")
+	r.SetBuffered(false)
+	if r.buf != nil {
+		t.Error("expected buffer to be removed when buffering is disabled")
+	}
+	r.Pass("unbuffered pass")
+}
+
+// TestSetBufferedFailure tests that buffered failures still fail the test
+func TestSetBufferedFailure(t *testing.T) {
+	r := detached(func(r *R) {
+		r.SetBuffered(true)
+		r.Fail("buffered failure")
+	})
+	if !r.Failed() {
+		t.Error("expected buffered failure to mark the test as failed")
+	}
+	if n := len(r.buf.lines); n != 1 {
+		t.Errorf("expected the failure to be buffered, got %d lines", n)
+	}
+}
+
+// TestFailureContext tests that buffered output is discarded when the test passes
+func TestFailureContext(t *testing.T) {
+	r := New(t, "Test FailureContext", WithFailureContext())
+	if r.buf == nil || !r.buf.quiet {
+		t.Fatal("expected WithFailureContext to enable quiet buffering")
+	}
+	r.Case("passing narrative")
+	r.Pass("step one")
+	if n := len(r.buf.lines); n != 3 {
+		t.Errorf("expected 3 buffered lines, got %d", n)
+	}
+	r.flushAtEnd()
+	if n := len(r.buf.lines); n != 0 {
+		t.Errorf("expected the output of a passing test to be discarded, got %d lines", n)
+	}
+
+	loud := New(t, "Test FailureContext", WithBuffered())
+	if loud.buf.quiet {
+		t.Error("expected WithBuffered alone to keep the output of passing tests")
+	}
+}
+
+// TestCallerLine tests that the lines logged through the runner are reported
+// at the caller's line rather than inside the package. The logging runs in a
+// child test process, since the location is only visible in the test output.
+func TestCallerLine(t *testing.T) {
+	if os.Getenv("GOT_CALLER_LINE") != "" {
+		r := New(t, "caller")
+		r.Case("caller case")
+		r.Log("caller log")
+		r.Logf("caller %s", "logf")
+		r.Pass("caller pass")
+		r.ExpectFailure("known").AssertTrue(false, "caller assert")
+		r.AssertEqual(1, 1)
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCallerLine$", "-test.v")
+	cmd.Env = append(os.Environ(), "GOT_CALLER_LINE=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("child test failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{"Test Case => caller", "Case 1 -> caller case", "caller log", "caller logf", "caller pass", "caller assert", "Values are equal"} {
+		found := false
+		for _, line := range strings.Split(string(out), "\n") {
+			if strings.HasSuffix(line, want) {
+				found = true
+				if !strings.Contains(line, "buffer_test.go:") {
+					t.Errorf("expected %q to be reported at buffer_test.go, got %q", want, strings.TrimSpace(line))
+				}
+			}
+		}
+		if !found {
+			t.Errorf("expected %q in the child output:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package got
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// hexRowSize is the number of bytes per row of a hexdump.
+	hexRowSize = 16
+	// hexContextRows is the number of rows shown around the first difference.
+	hexContextRows = 2
+)
+
+// AssertBytesEqual asserts that two byte slices are equal. On mismatch, the
+// first differing offset is reported together with a side-by-side hexdump of
+// both slices around it, with the differing row marked by ">". Only a few
+// rows around the difference are dumped, so large buffers stay readable.
+//
+// Example:
+//
+//	r.AssertBytesEqual(wantFrame, encoder.Encode(msg))
+func (r *R) AssertBytesEqual(expected, actual []byte, msg ...string) *R {
+	r.T.Helper()
+	if bytes.Equal(expected, actual) {
+		r.report(check{
+			ok:      true,
+			pass:    fmt.Sprintf("Byte slices are equal (%d bytes)", len(actual)),
+			notFail: fmt.Sprintf("Expected byte slices to differ, both are %d bytes", len(actual)),
+		}, msg)
+		return r
+	}
+	at := firstDiff(expected, actual)
+	r.report(check{
+		ok: false,
+		fail: fmt.Sprintf("Expected byte slices to be equal, but they differ at offset %d (0x%x); lengths %d and %d:\n%s",
+			at, at, len(expected), len(actual), hexDiff(expected, actual, at)),
+		notPass: fmt.Sprintf("Byte slices differ at offset %d", at),
+	}, msg)
+	return r
+}
+
+// firstDiff returns the offset of the first byte that differs between a and
+// b, or the length of the shorter one if it is a prefix of the other.
+func firstDiff(a, b []byte) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// hexDiff dumps the rows of expected and actual around offset at side by side.
+func hexDiff(expected, actual []byte, at int) string {
+	diffRow := at / hexRowSize
+	first := max(diffRow-hexContextRows, 0)
+	last := (max(len(expected), len(actual)) - 1) / hexRowSize
+	last = min(last, diffRow+hexContextRows)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\t  %-8s  %-*s  %s\n", "offset", hexRowSize*3-1, "expected", "actual")
+	for row := first; row <= last; row++ {
+		mark := " "
+		if row == diffRow {
+			mark = ">"
+		}
+		off := row * hexRowSize
+		fmt.Fprintf(&sb, "\t%s %08x  %-*s  %s\n", mark, off,
+			hexRowSize*3-1, hexRow(expected, off), hexRow(actual, off))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// hexRow formats the bytes of b in the row starting at off.
+func hexRow(b []byte, off int) string {
+	if off >= len(b) {
+		return ""
+	}
+	row := b[off:min(off+hexRowSize, len(b))]
+	parts := make([]string, len(row))
+	for i, c := range row {
+		parts[i] = fmt.Sprintf("%02x", c)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,37 @@
+package got
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestAssertBytesEqual tests byte slice comparison with a hexdump diff
+func TestAssertBytesEqual(t *testing.T) {
+	New(t, "Test AssertBytesEqual").
+		AssertBytesEqual([]byte("hello"), []byte("hello")).
+		AssertBytesEqual(nil, []byte{})
+
+	var out bytes.Buffer
+	expected := bytes.Repeat([]byte{0xaa}, 100)
+	actual := bytes.Clone(expected)
+	actual[70] = 0xbb
+	r := detached(func(r *R) { r.AssertBytesEqual(expected, actual) }, WithReporter(&out), WithColor(false))
+	if r.failures != 1 {
+		t.Fatalf("expected differing slices to fail once, got %d failures", r.failures)
+	}
+	dump := out.String()
+	for _, want := range []string{"offset 70 (0x46)", "> 00000040", "  00000020", "  00000060"} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("expected the dump to contain %q, got:\n%s", want, dump)
+		}
+	}
+	if strings.Contains(dump, "00000010") {
+		t.Errorf("expected rows far from the difference to be omitted, got:\n%s", dump)
+	}
+
+	r = detached(func(r *R) { r.AssertBytesEqual([]byte("abc"), []byte("abcd")) })
+	if r.failures != 1 {
+		t.Errorf("expected a longer slice to fail, got %d failures", r.failures)
+	}
+}
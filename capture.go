@@ -0,0 +1,158 @@
+package got
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"log/slog"
+	"sync"
+)
+
+// CaptureLog runs fn with the output of the standard library's default logger
+// redirected to a buffer and returns what was logged. Since the default slog
+// logger writes through the log package unless replaced, slog output is
+// captured too. The previous output is restored when fn returns or panics.
+// The default logger is global, so tests using CaptureLog must not run in
+// parallel with code that logs.
+//
+// Parameters:
+//   - fn: The function whose log output is captured
+//
+// Returns:
+//   - string: The captured log output
+//
+// Example:
+//
+//	out := r.CaptureLog(func() { svc.Start() })
+//	r.AssertContains(out, "listening on :8080")
+func (r *R) CaptureLog(fn func()) string {
+	var buf syncBuffer
+	prev := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(prev)
+	fn()
+	return buf.String()
+}
+
+// CaptureSlog runs fn with the default slog logger replaced by one that
+// records every log record, at all levels, and returns the records in the
+// order they were logged. Attributes added with Logger.With are included in
+// the records. The previous default logger, and the output and flags of the
+// log package, which slog.SetDefault changes, are restored when fn returns or
+// panics. Like CaptureLog, CaptureSlog changes global state.
+//
+// Parameters:
+//   - fn: The function whose slog records are captured
+//
+// Returns:
+//   - []slog.Record: The captured records
+//
+// Example:
+//
+//	records := r.CaptureSlog(func() { svc.Shutdown() })
+//	r.AssertEqual(1, len(records))
+//	r.AssertEqual(slog.LevelWarn, records[0].Level)
+func (r *R) CaptureSlog(fn func()) []slog.Record {
+	store := &recordStore{}
+	prev, prevOut, prevFlags := slog.Default(), log.Writer(), log.Flags()
+	slog.SetDefault(slog.New(&recordHandler{store: store}))
+	defer func() {
+		slog.SetDefault(prev)
+		log.SetOutput(prevOut)
+		log.SetFlags(prevFlags)
+	}()
+	fn()
+	return store.all()
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// recordStore holds the records captured by a recordHandler and the handlers
+// derived from it.
+type recordStore struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (s *recordStore) add(rec slog.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, rec)
+}
+
+func (s *recordStore) all() []slog.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]slog.Record(nil), s.records...)
+}
+
+// recordHandler is a slog.Handler that stores the records it handles.
+type recordHandler struct {
+	store  *recordStore
+	attrs  []slog.Attr // attributes added with WithAttrs outside of any group
+	groups []slogGroup // groups opened with WithGroup, outermost first
+}
+
+// slogGroup is a group opened with WithGroup and the attributes added to it.
+type slogGroup struct {
+	name  string
+	attrs []slog.Attr
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordHandler) Handle(_ context.Context, rec slog.Record) error {
+	var attrs []slog.Attr
+	rec.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		g := h.groups[i]
+		if len(attrs) == 0 && len(g.attrs) == 0 {
+			continue
+		}
+		attrs = []slog.Attr{{Key: g.name, Value: slog.GroupValue(append(append([]slog.Attr(nil), g.attrs...), attrs...)...)}}
+	}
+	out := slog.NewRecord(rec.Time, rec.Level, rec.Message, rec.PC)
+	out.AddAttrs(h.attrs...)
+	out.AddAttrs(attrs...)
+	h.store.add(out)
+	return nil
+}
+
+func (h *recordHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	if n := len(h.groups); n == 0 {
+		c.attrs = append(append([]slog.Attr(nil), h.attrs...), attrs...)
+	} else {
+		c.groups = append([]slogGroup(nil), h.groups...)
+		c.groups[n-1].attrs = append(append([]slog.Attr(nil), h.groups[n-1].attrs...), attrs...)
+	}
+	return &c
+}
+
+func (h *recordHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.groups = append(append([]slogGroup(nil), h.groups...), slogGroup{name: name})
+	return &c
+}
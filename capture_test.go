@@ -0,0 +1,56 @@
+package got_test
+
+import (
+	"log"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// TestCaptureLog tests capturing the output of the default logger
+func TestCaptureLog(t *testing.T) {
+	r := got.New(t, "Test CaptureLog")
+	prev := log.Writer()
+
+	r.Case("Testing log and slog output is captured")
+	out := r.CaptureLog(func() {
+		log.Printf("listening on %s", ":8080")
+		slog.Info("ready", "workers", 4)
+	})
+	r.AssertContainsInOrder(out, "listening on :8080", "ready workers=4")
+	r.AssertTrue(log.Writer() == prev, "log output should be restored")
+
+	r.Case("Testing output is restored on panic")
+	r.AssertPanics(func() { r.CaptureLog(func() { panic("boom") }) })
+	r.AssertTrue(log.Writer() == prev, "log output should be restored after a panic")
+}
+
+// TestCaptureSlog tests capturing slog records
+func TestCaptureSlog(t *testing.T) {
+	r := got.New(t, "Test CaptureSlog")
+	prev, prevFlags, prevOut := slog.Default(), log.Flags(), log.Writer()
+
+	r.Case("Testing records are captured at all levels")
+	records := r.CaptureSlog(func() {
+		slog.Debug("probing")
+		slog.With("svc", "api").WithGroup("req").With("id", 7).Warn("slow", "ms", 250)
+	})
+	r.AssertEqual(2, len(records))
+	r.AssertEqual(slog.LevelDebug, records[0].Level)
+	r.AssertEqual("slow", records[1].Message)
+	var attrs []string
+	records[1].Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a.String())
+		return true
+	})
+	r.AssertEqual("svc=api req=[id=7 ms=250]", strings.Join(attrs, " "))
+
+	r.Case("Testing the defaults are restored")
+	r.AssertTrue(slog.Default() == prev, "slog default should be restored")
+	r.AssertEqual(prevFlags, log.Flags())
+	r.AssertTrue(log.Writer() == prevOut, "log output should be restored")
+	r.AssertPanics(func() { r.CaptureSlog(func() { panic("boom") }) })
+	r.AssertTrue(slog.Default() == prev, "slog default should be restored after a panic")
+}
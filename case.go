@@ -35,6 +35,36 @@ type Case interface {
 	Err() error    // the error of the test case
 }
 
+// PanicCase is an optional interface for cases whose body is expected to
+// panic. When a case passed to Cases implements it, the body runs under
+// recover and the runner asserts that it panicked if WantPanic returns true,
+// or that it did not panic otherwise. Cases without the method run as usual.
+//
+// Example:
+//
+//	type divCase struct {
+//		got.Case
+//		panics bool
+//	}
+//
+//	func (c divCase) WantPanic() bool { return c.panics }
+type PanicCase interface {
+	WantPanic() bool // whether the body of the test case should panic
+}
+
+// wantPanic returns the result of WantPanic for c, looking through namedCase,
+// and whether c implements PanicCase.
+func wantPanic(c Case) (want bool, ok bool) {
+	if n, named := c.(*namedCase); named {
+		c = n.Case
+	}
+	p, ok := c.(PanicCase)
+	if !ok {
+		return false, false
+	}
+	return p.WantPanic(), true
+}
+
 // caseImpl is the default implementation of the Case interface.
 // It stores all the test case data in a simple struct format.
 type caseImpl struct {
@@ -70,6 +100,17 @@ func (c *caseImpl) Err() error {
 	return c.err
 }
 
+// namedCase overrides the name of a wrapped Case.
+type namedCase struct {
+	Case
+	name string
+}
+
+// Name returns the overriding name of the test case.
+func (c *namedCase) Name() string {
+	return c.name
+}
+
 // NewCase creates a new test case with the provided parameters.
 // This is the simplest way to create a test case for table-driven tests.
 //
@@ -0,0 +1,94 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Chain is a sequence of checks that stops at the first failure: once a check
+// fails, the following ones are neither evaluated nor reported, so only the
+// first failure is logged. It is created by R.Check.
+//
+// The arguments of a check are evaluated before the check runs, even if an
+// earlier check failed. Wrap checks that are only safe to evaluate after the
+// earlier ones passed, such as field accesses through a pointer checked with
+// NotNil, in Then.
+//
+// Example:
+//
+//	c := r.Check().
+//		NotNil(user).
+//		Then(func(r *got.R) { r.AssertEqual("alice", user.Name) }).
+//		Contains(roles, "admin")
+//	if c.Failed() {
+//		return
+//	}
+type Chain struct {
+	r      *R
+	failed bool
+}
+
+// Check starts a new chain of checks reporting through the runner.
+//
+// Returns:
+//   - *Chain: A new chain bound to this runner
+func (r *R) Check() *Chain {
+	return &Chain{r: r}
+}
+
+// NotNil checks that value is not nil. Unlike AssertNotNil, a nil pointer,
+// map, slice, function or channel stored in value also fails the check, so
+// that the following checks can dereference it safely.
+func (c *Chain) NotNil(value any, msg ...string) *Chain {
+	c.r.T.Helper()
+	return c.Then(func(r *R) {
+		r.T.Helper()
+		got := "nil"
+		if value != nil {
+			got = fmt.Sprintf("nil %T", value)
+		}
+		r.report(check{
+			ok:      value != nil && !isNilValue(reflect.ValueOf(value)),
+			pass:    "Value is not nil",
+			fail:    "Expected non-nil value, got " + got,
+			notPass: "Value is nil",
+			notFail: fmt.Sprintf("Expected nil, got %v", value),
+		}, msg)
+	})
+}
+
+// Equal checks that expected and actual are equal, like AssertEqual.
+func (c *Chain) Equal(expected, actual any, msg ...string) *Chain {
+	c.r.T.Helper()
+	return c.Then(func(r *R) {
+		r.T.Helper()
+		r.AssertEqual(expected, actual, msg...)
+	})
+}
+
+// Contains checks that container contains item, like AssertContains.
+func (c *Chain) Contains(container, item any, msg ...string) *Chain {
+	c.r.T.Helper()
+	return c.Then(func(r *R) {
+		r.T.Helper()
+		r.AssertContains(container, item, msg...)
+	})
+}
+
+// Then runs fn if no earlier check of the chain failed. The chain fails if
+// any assertion made by fn through the runner fails.
+func (c *Chain) Then(fn func(r *R)) *Chain {
+	c.r.T.Helper()
+	if c.failed {
+		return c
+	}
+	before := c.r.root().failures
+	fn(c.r)
+	c.failed = c.r.root().failures > before
+	return c
+}
+
+// Failed reports whether a check of the chain failed.
+func (c *Chain) Failed() bool {
+	return c.failed
+}
@@ -0,0 +1,41 @@
+package got_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestCheck tests the chain of checks stopping at the first failure
+func TestCheck(t *testing.T) {
+	r := got.New(t, "Test Check")
+	type user struct{ Name string }
+
+	r.Case("Testing passing chains")
+	u := &user{Name: "alice"}
+	c := r.Check().
+		NotNil(u).
+		Then(func(r *got.R) { r.AssertEqual("alice", u.Name) }).
+		Equal(1, 1).
+		Contains([]string{"admin", "dev"}, "admin")
+	r.AssertFalse(c.Failed())
+
+	r.Case("Testing short-circuiting")
+	var missing *user
+	ran := false
+	_, out := gottest.Detached(func(pr *got.R) {
+		c = pr.Check().
+			NotNil(missing).
+			Then(func(r *got.R) { ran = true; r.AssertEqual("alice", missing.Name) }).
+			Equal(1, 2)
+	})
+	r.AssertTrue(c.Failed())
+	r.AssertFalse(ran, "checks after a failure should not run")
+	r.AssertEqual(1, strings.Count(out, "[FAIL]"), out)
+
+	r.Case("Testing failures")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Check().Equal(1, 1).Contains("abc", "x") }), "failing check should fail the test")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Check().Then(func(r *got.R) { r.Fail("boom") }) }), "failing Then should fail the test")
+}
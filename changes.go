@@ -0,0 +1,65 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AssertChanges asserts that running action changes the value returned by
+// get, compared with reflect.DeepEqual. get is called once before and once
+// after action; it must return a fresh value, such as a copy of a slice, so
+// that action cannot modify the value captured before it ran.
+//
+// Example:
+//
+//	r.AssertChanges(func() any { return user.UpdatedAt }, func() { repo.Touch(user) })
+func (r *R) AssertChanges(get func() any, action func(), msg ...string) *R {
+	r.T.Helper()
+	before := get()
+	action()
+	after := get()
+	r.report(check{
+		ok:      !reflect.DeepEqual(before, after),
+		pass:    fmt.Sprintf("Value changed from %v to %v", before, after),
+		fail:    fmt.Sprintf("Expected value to change, but it stayed %v", before),
+		notPass: fmt.Sprintf("Value stayed %v", before),
+		notFail: fmt.Sprintf("Expected value not to change, but it changed from %v to %v", before, after),
+	}, msg)
+	return r
+}
+
+// AssertNotChanges asserts that running action leaves the value returned by
+// get unchanged, compared with reflect.DeepEqual. Use it to check that a
+// read-only operation has no side effects. See AssertChanges for the
+// requirements on get.
+//
+// Example:
+//
+//	r.AssertNotChanges(func() any { return repo.All() }, func() { repo.Find(1) })
+func (r *R) AssertNotChanges(get func() any, action func(), msg ...string) *R {
+	r.T.Helper()
+	r.Not().AssertChanges(get, action, msg...)
+	return r
+}
+
+// AssertChangesBy asserts that running action changes the number returned by
+// get by exactly delta, which may be negative. Use it for counters, such as
+// the number of rows in a table or of items in a queue.
+//
+// Example:
+//
+//	r.AssertChangesBy(queue.Len, 1, func() { queue.Push(job) })
+func (r *R) AssertChangesBy(get func() int, delta int, action func(), msg ...string) *R {
+	r.T.Helper()
+	before := get()
+	action()
+	after := get()
+	r.report(check{
+		ok:      after-before == delta,
+		pass:    fmt.Sprintf("Value changed by %d, from %d to %d", delta, before, after),
+		fail:    fmt.Sprintf("Expected value to change by %d, but it changed by %d, from %d to %d", delta, after-before, before, after),
+		notPass: fmt.Sprintf("Value changed by %d, not %d, from %d to %d", after-before, delta, before, after),
+		notFail: fmt.Sprintf("Expected value not to change by %d, but it did, from %d to %d", delta, before, after),
+	}, msg)
+	return r
+}
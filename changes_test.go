@@ -0,0 +1,38 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertChanges tests the AssertChanges, AssertNotChanges and AssertChangesBy methods
+func TestAssertChanges(t *testing.T) {
+	r := got.New(t, "Test AssertChanges")
+	var names []string
+	get := func() any { return append([]string(nil), names...) }
+	count := func() int { return len(names) }
+
+	r.Case("Testing changed values")
+	r.AssertChanges(get, func() { names = append(names, "alice") })
+	r.AssertChangesBy(count, 2, func() { names = append(names, "bob", "carol") })
+	r.AssertChangesBy(count, -1, func() { names = names[1:] })
+
+	r.Case("Testing unchanged values")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChanges(get, func() {}) }), "unchanged value should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChangesBy(count, 1, func() {}) }), "unchanged counter should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertChangesBy(count, 1, func() { names = append(names, "x", "y") })
+	}), "wrong delta should fail")
+
+	r.Case("Testing negation")
+	r.Not().AssertChanges(get, func() {})
+	r.AssertNotChanges(get, func() { _ = count() })
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertNotChanges(get, func() { names = append(names, "dave") })
+	}), "AssertNotChanges should fail on a change")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Not().AssertChanges(get, func() { names = nil })
+	}), "negated assertion should fail on a change")
+}
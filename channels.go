@@ -0,0 +1,194 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// AssertChannelReceives asserts that a value deeply equal to expected is
+// received from ch within timeout. ch may be any channel type that allows
+// receiving. The assertion fails if the channel is closed or nothing is
+// received in time, stating how long it waited.
+//
+// Example:
+//
+//	results := make(chan int)
+//	go worker(results)
+//	r.AssertChannelReceives(results, 42, time.Second)
+func (r *R) AssertChannelReceives(ch any, expected any, timeout time.Duration, msg ...string) *R {
+	r.T.Helper()
+	cv, ok := recvChan(ch)
+	if !ok {
+		r.Fail("Expected a receivable channel, got %T", ch)
+		return r
+	}
+	v, received, timedOut := recvTimeout(cv, timeout)
+	var fail string
+	switch {
+	case timedOut:
+		fail = fmt.Sprintf("Expected to receive %v, but nothing was received after waiting %v", expected, timeout)
+	case !received:
+		fail = fmt.Sprintf("Expected to receive %v, but the channel was closed", expected)
+	default:
+		fail = fmt.Sprintf("Expected to receive %v, got %v", expected, v)
+	}
+	r.report(check{
+		ok:      received && reflect.DeepEqual(expected, v),
+		pass:    fmt.Sprintf("Received %v from channel", expected),
+		fail:    fail,
+		notPass: fmt.Sprintf("Did not receive %v from channel", expected),
+		notFail: fmt.Sprintf("Expected not to receive %v from channel", expected),
+	}, msg)
+	return r
+}
+
+// AssertChannelClosed asserts that ch is closed within timeout. Values still
+// buffered in or sent to the channel are drained while waiting for it to close;
+// once timeout has elapsed, at most as many values as the channel's capacity
+// are drained before giving up. With a zero timeout, the check does not block:
+// it only drains the values that are ready and fails if the channel is not
+// closed then.
+//
+// Example:
+//
+//	server.Shutdown()
+//	r.AssertChannelClosed(server.Done(), 0)
+func (r *R) AssertChannelClosed(ch any, timeout time.Duration, msg ...string) *R {
+	r.T.Helper()
+	cv, ok := recvChan(ch)
+	if !ok {
+		r.Fail("Expected a receivable channel, got %T", ch)
+		return r
+	}
+	deadline := time.Now().Add(timeout)
+	// Once the deadline has passed, drain at most the values the buffer can
+	// hold, so that a sender that is always ready cannot keep the loop going.
+	closed, drained, extra := false, 0, cv.Cap()
+	for {
+		_, received, timedOut := recvTimeout(cv, time.Until(deadline))
+		if timedOut {
+			break
+		}
+		if !received {
+			closed = true
+			break
+		}
+		drained++
+		if time.Now().After(deadline) {
+			if extra == 0 {
+				break
+			}
+			extra--
+		}
+	}
+	waited := fmt.Sprintf(" after waiting %v", timeout)
+	if timeout <= 0 {
+		waited = ""
+	}
+	r.report(check{
+		ok:      closed,
+		pass:    fmt.Sprintf("Channel closed after draining %d values", drained),
+		fail:    fmt.Sprintf("Expected channel to be closed, but it was still open%s (drained %d values)", waited, drained),
+		notPass: "Channel still open" + waited,
+		notFail: "Expected channel not to be closed",
+	}, msg)
+	return r
+}
+
+// AssertChannelOpen asserts that ch is not closed, without blocking. It makes
+// a single non-blocking receive: the channel is open if nothing is ready, or
+// if a value is received, in which case the value is consumed. The check is a
+// snapshot, so it is racy if another goroutine may close the channel.
+//
+// Example:
+//
+//	r.AssertChannelOpen(server.Done(), "server should still be running")
+func (r *R) AssertChannelOpen(ch any, msg ...string) *R {
+	r.T.Helper()
+	cv, ok := recvChan(ch)
+	if !ok {
+		r.Fail("Expected a receivable channel, got %T", ch)
+		return r
+	}
+	v, ok := cv.TryRecv()
+	observed := "nothing ready to receive"
+	if v.IsValid() && ok {
+		observed = fmt.Sprintf("received %v", v)
+	}
+	closed := v.IsValid() && !ok
+	r.report(check{
+		ok:      !closed,
+		pass:    "Channel is open: " + observed,
+		fail:    "Expected channel to be open, but it was closed",
+		notPass: "Channel is closed",
+		notFail: "Expected channel to be closed, but it was open: " + observed,
+	}, msg)
+	return r
+}
+
+// AssertChannelLen asserts that ch has exactly expected values buffered,
+// without receiving any of them. ch may be a channel of any type and
+// direction. The length is a snapshot: it is racy if other goroutines send to
+// or receive from the channel while the assertion runs.
+//
+// Example:
+//
+//	jobs := make(chan Job, 10)
+//	producer.Enqueue(jobs, 3)
+//	r.AssertChannelLen(jobs, 3)
+func (r *R) AssertChannelLen(ch any, expected int, msg ...string) *R {
+	r.T.Helper()
+	cv := reflect.ValueOf(ch)
+	if cv.Kind() != reflect.Chan {
+		r.Fail("Expected a channel, got %T", ch)
+		return r
+	}
+	n := cv.Len()
+	r.report(check{
+		ok:      n == expected,
+		pass:    fmt.Sprintf("Channel has %d buffered values", expected),
+		fail:    fmt.Sprintf("Expected channel to have %d buffered values, got %d (capacity %d)", expected, n, cv.Cap()),
+		notPass: fmt.Sprintf("Channel has %d buffered values, not %d", n, expected),
+		notFail: fmt.Sprintf("Expected channel not to have %d buffered values", expected),
+	}, msg)
+	return r
+}
+
+// recvChan returns the reflect value of ch if it is a receivable channel.
+func recvChan(ch any) (reflect.Value, bool) {
+	cv := reflect.ValueOf(ch)
+	if cv.Kind() != reflect.Chan || cv.Type().ChanDir()&reflect.RecvDir == 0 {
+		return cv, false
+	}
+	return cv, true
+}
+
+// recvTimeout receives from cv, waiting at most timeout. It returns the value
+// received, whether a value was received (false if the channel is closed) and
+// whether the wait timed out.
+func recvTimeout(cv reflect.Value, timeout time.Duration) (v any, received, timedOut bool) {
+	// try first without blocking, so that ready values win over a zero timeout
+	if rv, ok := cv.TryRecv(); rv.IsValid() {
+		if !ok {
+			return nil, false, false
+		}
+		return rv.Interface(), true, false
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	chosen, rv, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: cv},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen == 1 {
+		return nil, false, true
+	}
+	if !ok {
+		return nil, false, false
+	}
+	return rv.Interface(), true, false
+}
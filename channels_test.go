@@ -0,0 +1,117 @@
+package got_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertChannelReceives tests receiving values from channels
+func TestAssertChannelReceives(t *testing.T) {
+	r := got.New(t, "Test AssertChannelReceives")
+
+	r.Case("Testing received values")
+	results := make(chan int)
+	go func() { results <- 42 }()
+	r.AssertChannelReceives(results, 42, time.Second)
+	buffered := make(chan string, 1)
+	buffered <- "done"
+	r.AssertChannelReceives((<-chan string)(buffered), "done", 0)
+
+	r.Case("Testing failures")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelReceives(make(chan int), 1, 10*time.Millisecond) }), "timeout should fail")
+	wrong := make(chan int, 1)
+	wrong <- 2
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelReceives(wrong, 1, time.Second) }), "different value should fail")
+	closed := make(chan int)
+	close(closed)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelReceives(closed, 0, time.Second) }), "closed channel should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelReceives(make(chan<- int), 0, time.Second) }), "send-only channel should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelReceives(42, 0, time.Second) }), "non-channel should fail")
+}
+
+// TestAssertChannelClosed tests waiting for channels to close
+func TestAssertChannelClosed(t *testing.T) {
+	r := got.New(t, "Test AssertChannelClosed")
+
+	r.Case("Testing closing channels")
+	done := make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(done)
+	}()
+	r.AssertChannelClosed(done, time.Second)
+	pending := make(chan int, 2)
+	pending <- 1
+	pending <- 2
+	close(pending)
+	r.AssertChannelClosed(pending, time.Second)
+
+	closed := make(chan int, 1)
+	closed <- 1
+	close(closed)
+	r.AssertChannelClosed(closed, 0)
+
+	r.Case("Testing open channels")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelClosed(make(chan int), 10*time.Millisecond) }), "open channel should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelClosed(make(chan int), 0) }), "open channel should fail without waiting")
+
+	r.Case("Testing a sender that never stops")
+	for _, size := range []int{0, 4} {
+		busy, stop := make(chan int, size), make(chan struct{})
+		for range 8 {
+			go func() {
+				for {
+					select {
+					case busy <- 1:
+					case <-stop:
+						return
+					}
+				}
+			}()
+		}
+		r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelClosed(busy, 0) }), "busy channel should fail without waiting")
+		r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelClosed(busy, 10*time.Millisecond) }), "busy channel should fail after the timeout")
+		close(stop)
+	}
+}
+
+// TestAssertChannelOpen tests checking that a channel is open without blocking
+func TestAssertChannelOpen(t *testing.T) {
+	r := got.New(t, "Test AssertChannelOpen")
+
+	r.Case("Testing open channels")
+	r.AssertChannelOpen(make(chan int))
+	ready := make(chan int, 1)
+	ready <- 1
+	r.AssertChannelOpen(ready)
+	r.AssertChannelLen(ready, 0, "the ready value should be consumed")
+
+	r.Case("Testing closed channels")
+	done := make(chan struct{})
+	close(done)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelOpen(done) }), "closed channel should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelOpen(make(chan<- int)) }), "send-only channel should fail")
+	r.Not().AssertChannelOpen(done)
+}
+
+// TestAssertChannelLen tests counting the values buffered in a channel
+func TestAssertChannelLen(t *testing.T) {
+	r := got.New(t, "Test AssertChannelLen")
+
+	r.Case("Testing buffered values")
+	jobs := make(chan int, 3)
+	r.AssertChannelLen(jobs, 0)
+	jobs <- 1
+	jobs <- 2
+	r.AssertChannelLen(jobs, 2).AssertChannelLen((chan<- int)(jobs), 2)
+	r.AssertEqual(1, <-jobs)
+	r.AssertChannelLen((<-chan int)(jobs), 1)
+
+	r.Case("Testing mismatching lengths")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelLen(jobs, 3) }), "wrong length should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertChannelLen([]int{1}, 1) }), "non-channel should fail")
+	r.Not().AssertChannelLen(jobs, 0)
+}
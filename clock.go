@@ -0,0 +1,112 @@
+package got
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time. Code under test that accepts a Clock
+// instead of calling time.Now directly can be driven deterministically with a
+// FakeClock.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock backed by time.Now.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock is the Clock that reports the real current time.
+var SystemClock Clock = systemClock{}
+
+// FakeClock is a Clock whose time only changes when it is set or advanced.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a fake clock frozen at t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the current time of the fake clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the fake clock forward by d and returns the new time.
+// A negative d moves the clock backward.
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+// Set moves the fake clock to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// WithFakeClock installs a fake clock frozen at t on the runner and returns it
+// so the test can advance it. The clock is shared with the runner's Not view
+// and is reported by Clock until the runner ends.
+//
+// Parameters:
+//   - t: The initial time of the fake clock
+//
+// Returns:
+//   - *FakeClock: The installed clock
+//
+// Example:
+//
+//	clock := r.WithFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+//	svc := NewService(r.Clock())
+//	clock.Advance(time.Hour)
+//	r.AssertTrue(svc.Expired(token))
+func (r *R) WithFakeClock(t time.Time) *FakeClock {
+	c := NewFakeClock(t)
+	r.root().clock = c
+	return c
+}
+
+// Clock returns the clock installed with WithFakeClock, or SystemClock if
+// none has been installed.
+func (r *R) Clock() Clock {
+	if c := r.root().clock; c != nil {
+		return c
+	}
+	return SystemClock
+}
+
+// AssertTimeWithin asserts that actual differs from expected by at most
+// tolerance, in either direction. Use it for timestamps that lose precision
+// when stored, such as in databases. On failure, both times and their
+// difference are reported.
+//
+// Example:
+//
+//	r.AssertTimeWithin(user.CreatedAt, loaded.CreatedAt, time.Second)
+func (r *R) AssertTimeWithin(expected, actual time.Time, tolerance time.Duration, msg ...string) *R {
+	r.T.Helper()
+	delta := actual.Sub(expected)
+	if delta < 0 {
+		delta = -delta
+	}
+	r.report(check{
+		ok:      delta <= tolerance,
+		pass:    fmt.Sprintf("Time %v is within %v of %v", actual, tolerance, expected),
+		fail:    fmt.Sprintf("Expected %v to be within %v of %v, but the difference is %v", actual, tolerance, expected, delta),
+		notPass: fmt.Sprintf("Time %v differs from %v by %v, more than %v", actual, expected, delta, tolerance),
+		notFail: fmt.Sprintf("Expected %v not to be within %v of %v, but the difference is %v", actual, tolerance, expected, delta),
+	}, msg)
+	return r
+}
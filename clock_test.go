@@ -0,0 +1,45 @@
+package got_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+func TestClock(t *testing.T) {
+	r := got.New(t, "Clock")
+
+	r.Case("system clock by default")
+	before := time.Now()
+	now := r.Clock().Now()
+	r.AssertFalse(now.Before(before), "system clock should report the real time")
+
+	r.Case("fake clock is frozen until advanced")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := r.WithFakeClock(start)
+	r.AssertEqual(start, r.Clock().Now())
+	r.AssertEqual(start, r.Not().Clock().Now(), "Not view should share the clock")
+	r.AssertEqual(start.Add(time.Hour), clock.Advance(time.Hour))
+	r.AssertEqual(start.Add(time.Hour), r.Clock().Now())
+
+	r.Case("fake clock can be set")
+	later := start.Add(48 * time.Hour)
+	clock.Set(later)
+	r.AssertEqual(later, r.Clock().Now())
+}
+
+func TestAssertTimeWithin(t *testing.T) {
+	r := got.New(t, "AssertTimeWithin")
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	r.Case("times within tolerance")
+	r.AssertTimeWithin(base, base.Add(300*time.Millisecond), time.Second)
+	r.AssertTimeWithin(base, base.Add(-time.Second), time.Second)
+	r.AssertTimeWithin(base, base.Truncate(time.Hour).In(time.Local), 0)
+
+	r.Case("times outside tolerance")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertTimeWithin(base, base.Add(2*time.Second), time.Second) }), "later time should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertTimeWithin(base, base.Add(-2*time.Second), time.Second) }), "earlier time should fail")
+}
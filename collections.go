@@ -0,0 +1,449 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// AssertSorted asserts that slice is sorted according to less, which has the
+// same semantics as the less function of sort.Slice. On failure, the first
+// out-of-order pair of indexes is reported.
+//
+// Example:
+//
+//	users := repo.ListByAge()
+//	r.AssertSorted(users, func(i, j int) bool { return users[i].Age < users[j].Age })
+func (r *R) AssertSorted(slice any, less func(i, j int) bool, msg ...string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(slice)
+	if !isList(rv) {
+		r.Fail("Expected a slice or array, got %T", slice)
+		return r
+	}
+	at := -1
+	for i := 1; i < rv.Len(); i++ {
+		if less(i, i-1) {
+			at = i
+			break
+		}
+	}
+	r.reportSorted(rv, at, "", msg)
+	return r
+}
+
+// AssertSortedAsc asserts that a slice or array of numbers or strings is
+// sorted in ascending order.
+func (r *R) AssertSortedAsc(slice any, msg ...string) *R {
+	r.T.Helper()
+	return r.assertSortedOrder(slice, 1, "ascending", msg)
+}
+
+// AssertSortedDesc asserts that a slice or array of numbers or strings is
+// sorted in descending order.
+func (r *R) AssertSortedDesc(slice any, msg ...string) *R {
+	r.T.Helper()
+	return r.assertSortedOrder(slice, -1, "descending", msg)
+}
+
+// assertSortedOrder checks that no element compares to its predecessor with
+// the opposite of dir (1 for ascending, -1 for descending).
+func (r *R) assertSortedOrder(slice any, dir int, order string, msg []string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(slice)
+	if !isList(rv) {
+		r.Fail("Expected a slice or array, got %T", slice)
+		return r
+	}
+	at := -1
+	for i := 1; i < rv.Len(); i++ {
+		c, ok := compareOrdered(rv.Index(i-1).Interface(), rv.Index(i).Interface())
+		if !ok {
+			r.Fail("Cannot compare elements of %T", slice)
+			return r
+		}
+		if c == dir {
+			at = i
+			break
+		}
+	}
+	r.reportSorted(rv, at, order+" ", msg)
+	return r
+}
+
+// reportSorted reports whether rv is sorted, given the index at which the
+// first out-of-order element was found, or -1.
+func (r *R) reportSorted(rv reflect.Value, at int, order string, msg []string) {
+	r.T.Helper()
+	fail := ""
+	if at > 0 {
+		fail = fmt.Sprintf("Expected slice to be sorted in %sorder, but elements at %d (%v) and %d (%v) are out of order",
+			order, at-1, rv.Index(at-1).Interface(), at, rv.Index(at).Interface())
+	}
+	r.report(check{
+		ok:      at < 0,
+		pass:    fmt.Sprintf("Slice is sorted in %sorder", order),
+		fail:    fail,
+		notPass: fmt.Sprintf("Slice is not sorted in %sorder", order),
+		notFail: fmt.Sprintf("Expected slice not to be sorted in %sorder", order),
+	}, msg)
+}
+
+// isList reports whether rv is a slice or an array.
+func isList(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+}
+
+// AssertSliceEqualUnordered asserts that two slices or arrays contain the same
+// elements with the same multiplicities, regardless of order. Elements are
+// matched with reflect.DeepEqual. On failure, the elements missing from actual
+// and the unexpected extra elements in actual are reported.
+//
+// Example:
+//
+//	r.AssertSliceEqualUnordered([]string{"a", "b", "b"}, tags)
+func (r *R) AssertSliceEqualUnordered(expected, actual any, msg ...string) *R {
+	r.T.Helper()
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !isList(ev) || !isList(av) {
+		r.Fail("Expected two slices or arrays, got %T and %T", expected, actual)
+		return r
+	}
+	missing, extra := multisetDiff(ev, av)
+	r.report(check{
+		ok:      len(missing) == 0 && len(extra) == 0,
+		pass:    fmt.Sprintf("Slices contain the same elements: %v", actual),
+		fail:    fmt.Sprintf("Expected %v in any order, got %v; missing: %v, extra: %v", expected, actual, missing, extra),
+		notPass: fmt.Sprintf("Slices contain different elements: %v and %v", expected, actual),
+		notFail: fmt.Sprintf("Expected %v and %v to contain different elements", expected, actual),
+	}, msg)
+	return r
+}
+
+// multisetDiff compares the elements of two lists as multisets. It returns the
+// elements of expected without a match in actual and the elements of actual
+// without a match in expected.
+func multisetDiff(expected, actual reflect.Value) (missing, extra []any) {
+	used := make([]bool, actual.Len())
+	for i := 0; i < expected.Len(); i++ {
+		e := expected.Index(i).Interface()
+		found := false
+		for j := 0; j < actual.Len(); j++ {
+			if !used[j] && reflect.DeepEqual(e, actual.Index(j).Interface()) {
+				used[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, e)
+		}
+	}
+	for j, u := range used {
+		if !u {
+			extra = append(extra, actual.Index(j).Interface())
+		}
+	}
+	return missing, extra
+}
+
+// AssertMapEqual asserts that two maps hold the same keys with deeply equal
+// values. On failure, the keys only in expected, the keys only in actual and
+// the keys whose values differ are listed separately, in sorted order.
+//
+// Example:
+//
+//	r.AssertMapEqual(map[string]string{"Content-Type": "application/json"}, headers)
+func (r *R) AssertMapEqual(expected, actual any, msg ...string) *R {
+	r.T.Helper()
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if ev.Kind() != reflect.Map || av.Kind() != reflect.Map || ev.Type() != av.Type() {
+		r.Fail("Expected two maps of the same type, got %T and %T", expected, actual)
+		return r
+	}
+	var missing, extra, changed []string
+	for _, k := range sortedMapKeys(ev) {
+		a := av.MapIndex(k)
+		switch {
+		case !a.IsValid():
+			missing = append(missing, fmt.Sprintf("%v", k))
+		case !reflect.DeepEqual(ev.MapIndex(k).Interface(), a.Interface()):
+			changed = append(changed, fmt.Sprintf("%v: expected %v, got %v", k, ev.MapIndex(k), a))
+		}
+	}
+	for _, k := range sortedMapKeys(av) {
+		if !ev.MapIndex(k).IsValid() {
+			extra = append(extra, fmt.Sprintf("%v", k))
+		}
+	}
+	var diffs []string
+	if len(missing) > 0 {
+		diffs = append(diffs, "missing keys: "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		diffs = append(diffs, "extra keys: "+strings.Join(extra, ", "))
+	}
+	if len(changed) > 0 {
+		diffs = append(diffs, "different values:\n\t\t"+strings.Join(changed, "\n\t\t"))
+	}
+	r.report(check{
+		ok:      len(diffs) == 0,
+		pass:    fmt.Sprintf("Maps are equal (%d keys)", ev.Len()),
+		fail:    "Expected maps to be equal, but:\n\t" + strings.Join(diffs, "\n\t"),
+		notPass: "Maps are not equal",
+		notFail: fmt.Sprintf("Expected maps not to be equal: %v", actual),
+	}, msg)
+	return r
+}
+
+// sortedMapKeys returns the keys of the map m ordered by their formatted value.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+// AssertSetEqual asserts that two maps used as sets, such as
+// map[string]struct{} or map[int]bool, have the same keys. The values are
+// ignored, so the maps may have different value types, but their key types
+// must be the same. On failure, the missing and extra keys are listed in
+// sorted order.
+//
+// Example:
+//
+//	r.AssertSetEqual(map[string]struct{}{"admin": {}, "dev": {}}, user.Roles)
+func (r *R) AssertSetEqual(expected, actual any, msg ...string) *R {
+	r.T.Helper()
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if ev.Kind() != reflect.Map || av.Kind() != reflect.Map || ev.Type().Key() != av.Type().Key() {
+		r.Fail("Expected two maps with the same key type, got %T and %T", expected, actual)
+		return r
+	}
+	var missing, extra []string
+	for _, k := range sortedMapKeys(ev) {
+		if !av.MapIndex(k).IsValid() {
+			missing = append(missing, fmt.Sprintf("%v", k))
+		}
+	}
+	for _, k := range sortedMapKeys(av) {
+		if !ev.MapIndex(k).IsValid() {
+			extra = append(extra, fmt.Sprintf("%v", k))
+		}
+	}
+	var diffs []string
+	if len(missing) > 0 {
+		diffs = append(diffs, "missing keys: "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		diffs = append(diffs, "extra keys: "+strings.Join(extra, ", "))
+	}
+	r.report(check{
+		ok:      len(diffs) == 0,
+		pass:    fmt.Sprintf("Sets are equal (%d keys)", ev.Len()),
+		fail:    "Expected sets to be equal, but:\n\t" + strings.Join(diffs, "\n\t"),
+		notPass: "Sets are not equal",
+		notFail: fmt.Sprintf("Expected sets not to be equal: %v", actual),
+	}, msg)
+	return r
+}
+
+// AssertLenBetween asserts that the length of container, a slice, array, map,
+// string or channel, is between min and max inclusive. Use it when the exact
+// length is not deterministic, such as the size of a random sample. On
+// failure, the actual length is reported.
+//
+// Example:
+//
+//	r.AssertLenBetween(sampler.Sample(users, 0.1), 5, 15)
+func (r *R) AssertLenBetween(container any, min, max int, msg ...string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(container)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+	default:
+		r.Fail("Expected a slice, array, map, string or channel, got %T", container)
+		return r
+	}
+	if min > max {
+		r.Fail("Invalid length range [%d, %d]: min is greater than max", min, max)
+		return r
+	}
+	n := rv.Len()
+	r.report(check{
+		ok:      n >= min && n <= max,
+		pass:    fmt.Sprintf("Length %d is between %d and %d", n, min, max),
+		fail:    fmt.Sprintf("Expected length between %d and %d, got %d", min, max, n),
+		notPass: fmt.Sprintf("Length %d is not between %d and %d", n, min, max),
+		notFail: fmt.Sprintf("Expected length not between %d and %d, got %d", min, max, n),
+	}, msg)
+	return r
+}
+
+// AssertCount asserts that exactly expected elements of a slice or array
+// satisfy pred. On failure, the actual count is reported.
+//
+// Example:
+//
+//	r.AssertCount(users, func(u any) bool { return u.(User).Active }, 2)
+func (r *R) AssertCount(slice any, pred func(any) bool, expected int, msg ...string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(slice)
+	if !isList(rv) {
+		r.Fail("Expected a slice or array, got %T", slice)
+		return r
+	}
+	count := 0
+	for i := 0; i < rv.Len(); i++ {
+		if pred(rv.Index(i).Interface()) {
+			count++
+		}
+	}
+	r.report(check{
+		ok:      count == expected,
+		pass:    fmt.Sprintf("%d of %d elements match", count, rv.Len()),
+		fail:    fmt.Sprintf("Expected %d matching elements, got %d of %d", expected, count, rv.Len()),
+		notPass: fmt.Sprintf("%d of %d elements match, not %d", count, rv.Len(), expected),
+		notFail: fmt.Sprintf("Expected the number of matching elements not to be %d", expected),
+	}, msg)
+	return r
+}
+
+// AssertNoDuplicates asserts that no element of a slice or array occurs more
+// than once. Comparable elements are compared with ==, others with
+// reflect.DeepEqual. On failure, each duplicated value is reported with the
+// indexes at which it occurs.
+//
+// Example:
+//
+//	ids := generateIDs(1000)
+//	r.AssertNoDuplicates(ids)
+func (r *R) AssertNoDuplicates(slice any, msg ...string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(slice)
+	if !isList(rv) {
+		r.Fail("Expected a slice or array, got %T", slice)
+		return r
+	}
+	dups := duplicates(rv)
+	r.report(check{
+		ok:      len(dups) == 0,
+		pass:    fmt.Sprintf("All %d elements are unique", rv.Len()),
+		fail:    "Expected no duplicates, but found: " + strings.Join(dups, ", "),
+		notPass: "Slice contains duplicates: " + strings.Join(dups, ", "),
+		notFail: fmt.Sprintf("Expected duplicates, but all %d elements are unique", rv.Len()),
+	}, msg)
+	return r
+}
+
+// duplicates describes the values occurring more than once in the list rv,
+// with their indexes, in order of first occurrence.
+func duplicates(rv reflect.Value) []string {
+	var groups [][]int // indexes of equal elements
+	comparable := rv.Type().Elem().Comparable()
+	seen := map[any]int{} // element to group, for comparable elements
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i).Interface()
+		g, hashed := -1, false
+		if comparable && (e == nil || reflect.TypeOf(e).Comparable()) {
+			g, hashed = groupOf(seen, e, len(groups))
+		}
+		if !hashed {
+			for at, idx := range groups {
+				if reflect.DeepEqual(e, rv.Index(idx[0]).Interface()) {
+					g = at
+					break
+				}
+			}
+		}
+		if g < 0 {
+			groups = append(groups, []int{i})
+		} else {
+			groups[g] = append(groups[g], i)
+		}
+	}
+	var dups []string
+	for _, idx := range groups {
+		if len(idx) > 1 {
+			dups = append(dups, fmt.Sprintf("%v at %v", rv.Index(idx[0]).Interface(), idx))
+		}
+	}
+	return dups
+}
+
+// groupOf returns the group recorded for e in seen, or records e as group
+// next and returns -1. It reports false if e cannot be hashed, such as a
+// struct whose interface field holds a slice, which a static Comparable check
+// does not catch.
+func groupOf(seen map[any]int, e any, next int) (g int, hashed bool) {
+	defer func() {
+		if recover() != nil {
+			g, hashed = -1, false
+		}
+	}()
+	if at, ok := seen[e]; ok {
+		return at, true
+	}
+	seen[e] = next
+	return -1, true
+}
+
+// AssertElementCounts asserts that each key of counts occurs exactly that many
+// times in a slice or array, and that no other element occurs. Elements are
+// matched against the keys with reflect.DeepEqual. On failure, the keys whose
+// counts differ and the unexpected elements are reported.
+//
+// Example:
+//
+//	r.AssertElementCounts(grades, map[any]int{"A": 2, "B": 1})
+func (r *R) AssertElementCounts(slice any, counts map[any]int, msg ...string) *R {
+	r.T.Helper()
+	rv := reflect.ValueOf(slice)
+	if !isList(rv) {
+		r.Fail("Expected a slice or array, got %T", slice)
+		return r
+	}
+	keys := make([]any, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+	actual := make([]int, len(keys))
+	var extra []any
+	var extraCounts []int
+next:
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i).Interface()
+		for j, k := range keys {
+			if reflect.DeepEqual(e, k) {
+				actual[j]++
+				continue next
+			}
+		}
+		for j, x := range extra {
+			if reflect.DeepEqual(e, x) {
+				extraCounts[j]++
+				continue next
+			}
+		}
+		extra, extraCounts = append(extra, e), append(extraCounts, 1)
+	}
+	var diffs []string
+	for j, k := range keys {
+		if actual[j] != counts[k] {
+			diffs = append(diffs, fmt.Sprintf("%v: expected %d, got %d", k, counts[k], actual[j]))
+		}
+	}
+	for j, x := range extra {
+		diffs = append(diffs, fmt.Sprintf("%v: unexpected, got %d", x, extraCounts[j]))
+	}
+	r.report(check{
+		ok:      len(diffs) == 0,
+		pass:    fmt.Sprintf("Element counts match: %v", counts),
+		fail:    "Expected element counts to match, but:\n\t" + strings.Join(diffs, "\n\t"),
+		notPass: "Element counts differ:\n\t" + strings.Join(diffs, "\n\t"),
+		notFail: fmt.Sprintf("Expected element counts not to be %v", counts),
+	}, msg)
+	return r
+}
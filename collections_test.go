@@ -0,0 +1,158 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertSorted tests the sort order assertions
+func TestAssertSorted(t *testing.T) {
+	r := got.New(t, "Test AssertSorted")
+
+	r.Case("Testing sorted slices")
+	people := []struct {
+		Name string
+		Age  int
+	}{{"a", 20}, {"b", 30}, {"c", 30}}
+	r.AssertSorted(people, func(i, j int) bool { return people[i].Age < people[j].Age })
+	r.AssertSortedAsc([]int{1, 2, 2, 5})
+	r.AssertSortedAsc([3]float64{-1.5, 0, 2})
+	r.AssertSortedDesc([]string{"c", "b", "a"})
+	r.AssertSortedAsc([]int{})
+
+	r.Case("Testing unsorted slices")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSortedAsc([]int{1, 3, 2}) }), "unsorted slice should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSortedDesc([]int{1, 2}) }), "ascending slice should fail AssertSortedDesc")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSortedAsc("abc") }), "non-slice should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSortedAsc([]any{1, "a"}) }), "incomparable elements should fail")
+}
+
+// TestAssertSliceEqualUnordered tests order-insensitive slice equality
+func TestAssertSliceEqualUnordered(t *testing.T) {
+	r := got.New(t, "Test AssertSliceEqualUnordered")
+
+	r.Case("Testing equal multisets")
+	r.AssertSliceEqualUnordered([]int{1, 2, 2, 3}, []int{2, 3, 1, 2})
+	r.AssertSliceEqualUnordered([]string{}, []string{})
+	r.AssertSliceEqualUnordered([2][]int{{1}, {2}}, [][]int{{2}, {1}})
+
+	r.Case("Testing different multisets")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSliceEqualUnordered([]int{1, 2, 2}, []int{1, 1, 2}) }), "different multiplicities should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSliceEqualUnordered([]int{1, 2}, []int{1, 2, 3}) }), "extra element should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSliceEqualUnordered([]int{1}, 1) }), "non-slice should fail")
+	r.Not().AssertSliceEqualUnordered([]int{1}, []int{2})
+}
+
+// TestAssertMapEqual tests map equality with per-key reporting
+func TestAssertMapEqual(t *testing.T) {
+	r := got.New(t, "Test AssertMapEqual")
+
+	r.Case("Testing equal maps")
+	r.AssertMapEqual(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1})
+	r.AssertMapEqual(map[int][]string{1: {"x"}}, map[int][]string{1: {"x"}})
+	r.AssertMapEqual(map[string]int{}, map[string]int(nil))
+
+	r.Case("Testing different maps")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertMapEqual(map[string]int{"a": 1}, map[string]int{}) }), "missing key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertMapEqual(map[string]int{}, map[string]int{"a": 1}) }), "extra key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertMapEqual(map[string]int{"a": 1}, map[string]int{"a": 2}) }), "different value should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertMapEqual(map[string]int{}, map[string]int64{}) }), "different map types should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertMapEqual(map[string]int{}, []int{}) }), "non-map should fail")
+}
+
+// TestAssertSetEqual tests comparing the key sets of maps
+func TestAssertSetEqual(t *testing.T) {
+	r := got.New(t, "Test AssertSetEqual")
+
+	r.Case("Testing equal sets")
+	r.AssertSetEqual(map[string]struct{}{"a": {}, "b": {}}, map[string]struct{}{"b": {}, "a": {}})
+	r.AssertSetEqual(map[string]struct{}{"a": {}}, map[string]bool{"a": false})
+	r.AssertSetEqual(map[int]bool{}, map[int]string(nil))
+
+	r.Case("Testing different sets")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSetEqual(map[string]bool{"a": true}, map[string]bool{}) }), "missing key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSetEqual(map[string]bool{}, map[string]int{"a": 1}) }), "extra key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSetEqual(map[string]bool{}, map[int]bool{}) }), "different key types should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSetEqual(map[string]bool{}, []string{}) }), "non-map should fail")
+	r.Not().AssertSetEqual(map[int]bool{1: true}, map[int]bool{2: true})
+}
+
+// TestAssertLenBetween tests checking lengths against an inclusive range
+func TestAssertLenBetween(t *testing.T) {
+	r := got.New(t, "Test AssertLenBetween")
+
+	r.Case("Testing lengths within the range")
+	r.AssertLenBetween([]int{1, 2, 3}, 1, 3).
+		AssertLenBetween([2]string{}, 2, 2).
+		AssertLenBetween(map[string]int{"a": 1}, 0, 5).
+		AssertLenBetween("hello", 5, 10).
+		AssertLenBetween([]int(nil), 0, 0)
+	ch := make(chan int, 2)
+	ch <- 1
+	r.AssertLenBetween(ch, 1, 2)
+
+	r.Case("Testing lengths outside the range")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLenBetween([]int{1}, 2, 4) }), "too short should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLenBetween("hello", 0, 4) }), "too long should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLenBetween(42, 0, 4) }), "value without length should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLenBetween([]int{}, 3, 1) }), "invalid range should fail")
+	r.Not().AssertLenBetween([]int{1, 2, 3}, 0, 2)
+}
+
+// TestAssertCount tests counting elements matching a predicate
+func TestAssertCount(t *testing.T) {
+	r := got.New(t, "Test AssertCount")
+	even := func(v any) bool { return v.(int)%2 == 0 }
+
+	r.Case("Testing matching counts")
+	r.AssertCount([]int{1, 2, 3, 4}, even, 2)
+	r.AssertCount([3]int{1, 3, 5}, even, 0)
+	r.AssertCount([]string{"a", "bb", "cc"}, func(v any) bool { return len(v.(string)) == 2 }, 2)
+
+	r.Case("Testing mismatching counts")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertCount([]int{2, 4}, even, 1) }), "different count should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertCount(2, even, 1) }), "non-slice should fail")
+}
+
+// TestAssertNoDuplicates tests asserting that slice elements are unique
+func TestAssertNoDuplicates(t *testing.T) {
+	r := got.New(t, "Test AssertNoDuplicates")
+
+	r.Case("Testing unique elements")
+	r.AssertNoDuplicates([]string{"a", "b", "c"})
+	r.AssertNoDuplicates([2][]int{{1}, {2}})
+	r.AssertNoDuplicates([]any{1, "1", []int{1}, nil})
+	r.AssertNoDuplicates([]any{struct{ X any }{[]int{1}}, struct{ X any }{[]int{2}}, 1})
+	r.AssertNoDuplicates([]int{})
+	r.Not().AssertNoDuplicates([]int{1, 2, 1})
+
+	r.Case("Testing duplicated elements")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoDuplicates([]int{1, 2, 1, 3, 2}) }), "duplicated ints should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoDuplicates([][]int{{1}, {1}}) }), "deeply equal slices should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoDuplicates([]any{nil, nil}) }), "duplicated nils should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertNoDuplicates([]any{struct{ X any }{[]int{1}}, struct{ X any }{[]int{1}}})
+	}), "deeply equal unhashable values should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoDuplicates("abc") }), "non-slice should fail")
+}
+
+// TestAssertElementCounts tests asserting on the number of occurrences of elements
+func TestAssertElementCounts(t *testing.T) {
+	r := got.New(t, "Test AssertElementCounts")
+
+	r.Case("Testing matching counts")
+	r.AssertElementCounts([]string{"A", "B", "A"}, map[any]int{"A": 2, "B": 1})
+	r.AssertElementCounts([]string{"A"}, map[any]int{"A": 1, "x": 0}, "zero counts are allowed for absent keys")
+	r.Not().AssertElementCounts([]int{1, 1}, map[any]int{1: 1})
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertElementCounts([][]int{{1}, {1}}, map[any]int{})
+	}), "unmatched elements should be reported as unexpected")
+
+	r.Case("Testing mismatching counts")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertElementCounts([]string{"A", "B"}, map[any]int{"A": 2, "B": 1}) }), "wrong count should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertElementCounts([]string{"A", "C"}, map[any]int{"A": 1}) }), "extra element should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertElementCounts([]int{1}, map[any]int{int64(1): 1}) }), "different types should not match")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertElementCounts(map[string]int{}, map[any]int{}) }), "non-slice should fail")
+}
@@ -0,0 +1,351 @@
+package got
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// coder is implemented by errors that carry a domain code.
+type coder interface {
+	Code() string
+}
+
+// AssertErrorCode asserts that err, or any error in its chain, carries the
+// expected code through a Code() string method. The chain is traversed the
+// same way errors.As does, including errors joined with errors.Join. On
+// failure, the codes found along the chain are reported.
+//
+// Parameters:
+//   - err: The error to inspect
+//   - expected: The expected code
+//   - msg: An optional custom failure message
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	_, err := svc.Find(ctx, id)
+//	r.AssertErrorCode(err, "NOT_FOUND")
+func (r *R) AssertErrorCode(err error, expected string, msg ...string) *R {
+	r.T.Helper()
+	var codes []string
+	found := false
+	for _, e := range errorChain(err) {
+		if c, ok := e.(coder); ok {
+			codes = append(codes, c.Code())
+			if c.Code() == expected {
+				found = true
+			}
+		}
+	}
+	fail := fmt.Sprintf("Expected error code %q, found codes [%s] in %v", expected, strings.Join(codes, ", "), err)
+	if len(codes) == 0 {
+		fail = fmt.Sprintf("Expected error code %q, but no error in the chain has a code: %v", expected, err)
+	}
+	r.report(check{
+		ok:      found,
+		pass:    fmt.Sprintf("Error has code %q", expected),
+		fail:    fail,
+		notPass: fmt.Sprintf("Error does not have code %q", expected),
+		notFail: fmt.Sprintf("Expected error not to have code %q", expected),
+	}, msg)
+	return r
+}
+
+// AssertErrorChainLength asserts that err is wrapped exactly expected times,
+// that is, its chain as walked by repeated errors.Unwrap calls contains
+// expected errors including err itself. A nil error has a chain of length 0.
+// On failure, the message of each layer is reported.
+//
+// Parameters:
+//   - err: The error to inspect
+//   - expected: The expected number of errors in the chain
+//   - msg: An optional custom failure message
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	err := handler(req)
+//	r.AssertErrorChainLength(err, 3) // handler -> service -> repository
+func (r *R) AssertErrorChainLength(err error, expected int, msg ...string) *R {
+	r.T.Helper()
+	var layers []string
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		layers = append(layers, fmt.Sprintf("  %d: %s", len(layers)+1, e.Error()))
+	}
+	detail := ""
+	if len(layers) > 0 {
+		detail = "\n" + strings.Join(layers, "\n")
+	}
+	r.report(check{
+		ok:      len(layers) == expected,
+		pass:    fmt.Sprintf("Error chain has length %d", expected),
+		fail:    fmt.Sprintf("Expected error chain of length %d, got %d%s", expected, len(layers), detail),
+		notPass: fmt.Sprintf("Error chain length %d is not %d", len(layers), expected),
+		notFail: fmt.Sprintf("Expected error chain length not to be %d%s", expected, detail),
+	}, msg)
+	return r
+}
+
+// AssertJoinedErrors asserts that err, typically built with errors.Join,
+// contains every target, as reported by errors.Is. On failure, the missing
+// targets and the full error text are reported.
+//
+// Parameters:
+//   - err: The error to inspect
+//   - targets: The errors err must contain
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	err := validate(form)
+//	r.AssertJoinedErrors(err, ErrEmptyName, ErrInvalidEmail)
+func (r *R) AssertJoinedErrors(err error, targets ...error) *R {
+	r.T.Helper()
+	var missing []string
+	for _, target := range targets {
+		if !errors.Is(err, target) {
+			missing = append(missing, fmt.Sprintf("%q", target))
+		}
+	}
+	fail := fmt.Sprintf("Expected error to contain %s, but they are missing from: %v", strings.Join(missing, ", "), err)
+	if err == nil {
+		fail = "Expected a joined error, got nil"
+	}
+	r.report(check{
+		ok:      err != nil && len(missing) == 0,
+		pass:    fmt.Sprintf("Error contains all %d targets", len(targets)),
+		fail:    fail,
+		notPass: fmt.Sprintf("Error does not contain all targets: %v", err),
+		notFail: fmt.Sprintf("Expected error not to contain all %d targets: %v", len(targets), err),
+	}, nil)
+	return r
+}
+
+// AssertErrorType asserts that err, or any error in its chain, has the same
+// concrete type as sample. The chain is traversed the same way errors.As
+// does, including errors joined with errors.Join. Only the type of sample
+// matters, so a zero value such as &NotFoundError{} is enough. On failure,
+// the types found along the chain are reported.
+//
+// Example:
+//
+//	r.AssertErrorType(err, &fs.PathError{})
+func (r *R) AssertErrorType(err error, sample error, msg ...string) *R {
+	r.T.Helper()
+	want := reflect.TypeOf(sample)
+	var types []string
+	found := false
+	for _, e := range errorChain(err) {
+		types = append(types, reflect.TypeOf(e).String())
+		found = found || reflect.TypeOf(e) == want
+	}
+	r.report(check{
+		ok:      err != nil && found,
+		pass:    fmt.Sprintf("Error chain contains a %v", want),
+		fail:    fmt.Sprintf("Expected error chain to contain a %v, got types [%s]", want, strings.Join(types, ", ")),
+		notPass: fmt.Sprintf("Error chain does not contain a %v", want),
+		notFail: fmt.Sprintf("Expected error chain not to contain a %v, got types [%s]", want, strings.Join(types, ", ")),
+	}, msg)
+	return r
+}
+
+// AssertErrorMessage asserts that err is not nil and that its message equals
+// expected exactly. On failure, both messages are reported.
+//
+// Example:
+//
+//	r.AssertErrorMessage(err, "user 42 not found")
+func (r *R) AssertErrorMessage(err error, expected string, msg ...string) *R {
+	r.T.Helper()
+	if err == nil {
+		r.report(check{
+			ok:      false,
+			fail:    fmt.Sprintf("Expected error with message %q, got nil", expected),
+			notPass: fmt.Sprintf("No error with message %q", expected),
+		}, msg)
+		return r
+	}
+	r.report(check{
+		ok:      err.Error() == expected,
+		pass:    fmt.Sprintf("Error message is %q", expected),
+		fail:    fmt.Sprintf("Expected error message %q, got %q", expected, err.Error()),
+		notPass: fmt.Sprintf("Error message %q is not %q", err.Error(), expected),
+		notFail: fmt.Sprintf("Expected error message not to be %q", expected),
+	}, msg)
+	return r
+}
+
+// AssertErrorMessagef is like AssertErrorMessage, with the expected message
+// built with fmt.Sprintf from format and args.
+//
+// Example:
+//
+//	r.AssertErrorMessagef(err, "user %d not found", id)
+func (r *R) AssertErrorMessagef(err error, format string, args ...any) *R {
+	r.T.Helper()
+	return r.AssertErrorMessage(err, fmt.Sprintf(format, args...))
+}
+
+// AssertErrorEqual asserts that two errors are equal by value: both nil, or
+// both non-nil with the same message, or related by errors.Is in either
+// direction. It is more forgiving than AssertEqual, which compares errors with
+// reflect.DeepEqual, and suits table tests comparing the error returned with
+// the Err() of the case. On failure, both messages are reported.
+//
+// Example:
+//
+//	_, err := parse(c.Input().(string))
+//	r.AssertErrorEqual(c.Err(), err)
+func (r *R) AssertErrorEqual(expected, actual error, msg ...string) *R {
+	r.T.Helper()
+	equal := expected == nil && actual == nil
+	if expected != nil && actual != nil {
+		equal = expected.Error() == actual.Error() || errors.Is(actual, expected) || errors.Is(expected, actual)
+	}
+	r.report(check{
+		ok:      equal,
+		pass:    fmt.Sprintf("Errors are equal: %s", describeError(actual)),
+		fail:    fmt.Sprintf("Expected error %s, got %s", describeError(expected), describeError(actual)),
+		notPass: fmt.Sprintf("Errors are different: %s and %s", describeError(expected), describeError(actual)),
+		notFail: fmt.Sprintf("Expected errors to be different, both are %s", describeError(actual)),
+	}, msg)
+	return r
+}
+
+// describeError formats err, which may be nil, for failure messages.
+func describeError(err error) string {
+	if err == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%q", err.Error())
+}
+
+// fielder is implemented by validation errors that name the invalid field.
+type fielder interface {
+	Field() string
+}
+
+// AssertFieldError asserts that the validation errors errs include an error
+// for field. errs may be a map with string keys, such as map[string]error, a
+// slice or array whose elements implement Field() string, or an error whose
+// chain contains such errors, as returned by errors.Join. On failure, all the
+// field errors present are listed.
+//
+// Example:
+//
+//	errs := validate(form)
+//	r.AssertFieldError(errs, "email").AssertNoFieldError(errs, "name")
+func (r *R) AssertFieldError(errs any, field string, msg ...string) *R {
+	r.T.Helper()
+	fields, ok := fieldErrors(errs)
+	if !ok {
+		r.Fail("Expected field errors as a map, a slice of Field() errors or an error, got %T", errs)
+		return r
+	}
+	_, found := fields[field]
+	r.report(check{
+		ok:      found,
+		pass:    fmt.Sprintf("Field %q has an error: %s", field, fields[field]),
+		fail:    fmt.Sprintf("Expected an error for field %q, got %s", field, describeFieldErrors(fields)),
+		notPass: fmt.Sprintf("Field %q has no error", field),
+		notFail: fmt.Sprintf("Expected no error for field %q, got %s", field, describeFieldErrors(fields)),
+	}, msg)
+	return r
+}
+
+// AssertNoFieldError asserts that the validation errors errs do not include
+// an error for field. See AssertFieldError for the supported forms of errs.
+func (r *R) AssertNoFieldError(errs any, field string, msg ...string) *R {
+	r.T.Helper()
+	r.Not().AssertFieldError(errs, field, msg...)
+	return r
+}
+
+// fieldErrors collects the messages of the validation errors in errs by field.
+// The second result is false if errs has an unsupported type.
+func fieldErrors(errs any) (map[string]string, bool) {
+	fields := make(map[string]string)
+	if errs == nil {
+		return fields, true
+	}
+	if err, ok := errs.(error); ok {
+		for _, e := range errorChain(err) {
+			if f, ok := e.(fielder); ok {
+				fields[f.Field()] = e.Error()
+			}
+		}
+		return fields, true
+	}
+	rv := reflect.ValueOf(errs)
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		for _, k := range rv.MapKeys() {
+			if v := rv.MapIndex(k); !isNilValue(v) {
+				fields[k.String()] = fmt.Sprint(v)
+			}
+		}
+	case isList(rv):
+		for i := 0; i < rv.Len(); i++ {
+			f, ok := rv.Index(i).Interface().(fielder)
+			if !ok {
+				return nil, false
+			}
+			fields[f.Field()] = fmt.Sprint(f)
+		}
+	default:
+		return nil, false
+	}
+	return fields, true
+}
+
+// isNilValue reports whether v holds a nil interface, pointer, map or slice.
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
+
+// describeFieldErrors lists field errors in field order for failure messages.
+func describeFieldErrors(fields map[string]string) string {
+	if len(fields) == 0 {
+		return "no field errors"
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s: %s", name, fields[name])
+	}
+	return "field errors [" + strings.Join(names, "; ") + "]"
+}
+
+// errorChain returns err followed by every error reachable from it through
+// Unwrap() error and Unwrap() []error, in depth-first order.
+func errorChain(err error) []error {
+	if err == nil {
+		return nil
+	}
+	chain := []error{err}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		chain = append(chain, errorChain(e.Unwrap())...)
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			chain = append(chain, errorChain(inner)...)
+		}
+	}
+	return chain
+}
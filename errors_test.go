@@ -0,0 +1,154 @@
+package got_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// codedError is a test error carrying a domain code
+type codedError struct {
+	code string
+}
+
+func (e *codedError) Error() string { return "coded error " + e.code }
+func (e *codedError) Code() string  { return e.code }
+
+// TestAssertErrorCode tests asserting codes along the error chain
+func TestAssertErrorCode(t *testing.T) {
+	r := got.New(t, "Test AssertErrorCode")
+	notFound := &codedError{code: "NOT_FOUND"}
+
+	r.Case("Testing codes found in the chain")
+	r.AssertErrorCode(notFound, "NOT_FOUND")
+	r.AssertErrorCode(fmt.Errorf("find user: %w", notFound), "NOT_FOUND")
+	r.AssertErrorCode(errors.Join(errors.New("other"), notFound), "NOT_FOUND")
+	r.AssertErrorCode(fmt.Errorf("%w: %w", &codedError{code: "DB"}, notFound), "NOT_FOUND")
+
+	r.Case("Testing missing codes")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorCode(notFound, "CONFLICT") }), "different code should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorCode(errors.New("plain"), "NOT_FOUND") }), "error without code should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorCode(nil, "NOT_FOUND") }), "nil error should fail")
+}
+
+// TestAssertErrorChainLength tests asserting the wrapping depth of errors
+func TestAssertErrorChainLength(t *testing.T) {
+	r := got.New(t, "Test AssertErrorChainLength")
+	base := errors.New("not found")
+	wrapped := fmt.Errorf("service: %w", fmt.Errorf("repository: %w", base))
+
+	r.Case("Testing matching lengths")
+	r.AssertErrorChainLength(nil, 0)
+	r.AssertErrorChainLength(base, 1)
+	r.AssertErrorChainLength(wrapped, 3)
+	r.AssertErrorChainLength(fmt.Errorf("context only: %v", base), 1)
+
+	r.Case("Testing mismatched lengths")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorChainLength(wrapped, 2) }), "shorter expected length should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorChainLength(base, 0) }), "non-nil error with length 0 should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().AssertErrorChainLength(wrapped, 3) }), "negated match should fail")
+}
+
+// TestAssertJoinedErrors tests asserting on the members of joined errors
+func TestAssertJoinedErrors(t *testing.T) {
+	r := got.New(t, "Test AssertJoinedErrors")
+	errName := errors.New("name is empty")
+	errEmail := errors.New("email is invalid")
+	errAge := errors.New("age is negative")
+	joined := errors.Join(errName, fmt.Errorf("contact: %w", errEmail))
+
+	r.Case("Testing present members")
+	r.AssertJoinedErrors(joined, errName, errEmail)
+	r.AssertJoinedErrors(joined, errEmail)
+	r.Not().AssertJoinedErrors(joined, errName, errAge)
+
+	r.Case("Testing missing members")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJoinedErrors(joined, errName, errAge) }), "missing member should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJoinedErrors(nil, errName) }), "nil error should fail")
+}
+
+// TestAssertErrorType tests matching the concrete types of errors in a chain
+func TestAssertErrorType(t *testing.T) {
+	r := got.New(t, "Test AssertErrorType")
+	_, pathErr := os.Open("/does/not/exist")
+	wrapped := fmt.Errorf("load config: %w", pathErr)
+	joined := errors.Join(errors.New("first"), wrapped)
+
+	r.Case("Testing matching types")
+	r.AssertErrorType(pathErr, &fs.PathError{})
+	r.AssertErrorType(wrapped, &fs.PathError{})
+	r.AssertErrorType(joined, &fs.PathError{})
+	r.Not().AssertErrorType(wrapped, &json.SyntaxError{})
+
+	r.Case("Testing mismatching types")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorType(wrapped, &json.SyntaxError{}) }), "different type should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorType(nil, &fs.PathError{}) }), "nil error should fail")
+}
+
+// TestAssertErrorMessage tests exact error message assertions
+func TestAssertErrorMessage(t *testing.T) {
+	r := got.New(t, "Test AssertErrorMessage")
+	err := fmt.Errorf("user %d not found", 42)
+
+	r.Case("Testing matching messages")
+	r.AssertErrorMessage(err, "user 42 not found")
+	r.AssertErrorMessagef(err, "user %d not found", 42)
+	r.Not().AssertErrorMessage(err, "user 43 not found")
+	r.Not().AssertErrorMessage(nil, "user 42 not found")
+
+	r.Case("Testing mismatching messages")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorMessage(err, "user 42") }), "partial message should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorMessagef(err, "user %d not found", 7) }), "different formatted message should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorMessage(nil, "user 42 not found") }), "nil error should fail")
+}
+
+type fieldError struct{ field, reason string }
+
+func (e fieldError) Error() string { return e.field + " " + e.reason }
+func (e fieldError) Field() string { return e.field }
+
+// TestAssertFieldError tests assertions on validation errors by field
+func TestAssertFieldError(t *testing.T) {
+	r := got.New(t, "Test AssertFieldError")
+	list := []fieldError{{"email", "is invalid"}, {"age", "must be positive"}}
+	joined := errors.Join(list[0], fmt.Errorf("wrapped: %w", list[1]))
+	byField := map[string]error{"email": list[0], "name": nil}
+
+	r.Case("Testing fields with errors")
+	for _, errs := range []any{list, joined, byField} {
+		r.AssertFieldError(errs, "email")
+		r.AssertNoFieldError(errs, "name")
+	}
+	r.AssertFieldError(joined, "age")
+	r.AssertNoFieldError(nil, "email")
+
+	r.Case("Testing fields without errors")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFieldError(list, "name") }), "missing field error should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoFieldError(joined, "email") }), "unexpected field error should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFieldError([]error{errors.New("plain")}, "email") }), "elements without Field should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFieldError(42, "email") }), "unsupported type should fail")
+}
+
+// TestAssertErrorEqual tests comparing errors by value
+func TestAssertErrorEqual(t *testing.T) {
+	r := got.New(t, "Test AssertErrorEqual")
+	errNotFound := errors.New("not found")
+
+	r.Case("Testing equal errors")
+	r.AssertErrorEqual(nil, nil).
+		AssertErrorEqual(errors.New("boom"), errors.New("boom")).
+		AssertErrorEqual(errNotFound, fmt.Errorf("user 1: %w", errNotFound)).
+		AssertErrorEqual(fmt.Errorf("user 1: %w", errNotFound), errNotFound)
+
+	r.Case("Testing different errors")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorEqual(errNotFound, nil) }), "nil actual should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorEqual(nil, errNotFound) }), "unexpected error should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertErrorEqual(errNotFound, errors.New("timeout")) }), "different messages should fail")
+	r.Not().AssertErrorEqual(errNotFound, errors.New("timeout"))
+}
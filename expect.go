@@ -0,0 +1,156 @@
+package got
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+)
+
+// Expectation is a fluent wrapper around a single value that allows writing
+// assertions in a spec-like style. It is created by R.Expect and every matcher
+// reports through the runner's Pass/Fail machinery and returns the expectation
+// for chaining.
+//
+// Example:
+//
+//	r.Expect(result).ToEqual(5).ToBeGreaterThan(3)
+//	r.Expect(err).ToBeNil()
+//	r.Expect([]string{"a", "b"}).ToContain("a")
+type Expectation struct {
+	r     *R
+	value any
+}
+
+// Expect creates a new expectation for the given value.
+//
+// Parameters:
+//   - value: The actual value to make assertions on
+//
+// Returns:
+//   - *Expectation: A new expectation bound to this runner
+//
+// Example:
+//
+//	r.Expect(len(items)).ToEqual(3)
+func (r *R) Expect(value any) *Expectation {
+	return &Expectation{r: r, value: value}
+}
+
+// ToEqual asserts that the value is deeply equal to expected.
+func (e *Expectation) ToEqual(expected any, msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.r.AssertEqual(expected, e.value, msg...)
+	return e
+}
+
+// ToNotEqual asserts that the value is not deeply equal to expected.
+func (e *Expectation) ToNotEqual(expected any, msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.r.AssertNotEqual(expected, e.value, msg...)
+	return e
+}
+
+// ToBeNil asserts that the value is nil.
+func (e *Expectation) ToBeNil(msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.r.AssertNil(e.value, msg...)
+	return e
+}
+
+// ToNotBeNil asserts that the value is not nil.
+func (e *Expectation) ToNotBeNil(msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.r.AssertNotNil(e.value, msg...)
+	return e
+}
+
+// ToContain asserts that the value (a string, slice or array) contains item.
+func (e *Expectation) ToContain(item any, msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.r.AssertContains(e.value, item, msg...)
+	return e
+}
+
+// ToBeGreaterThan asserts that the value is greater than n.
+// Both values must be numbers or strings of comparable kinds.
+func (e *Expectation) ToBeGreaterThan(n any, msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.order(n, 1, "greater than", msg)
+	return e
+}
+
+// ToBeLessThan asserts that the value is less than n.
+// Both values must be numbers or strings of comparable kinds.
+func (e *Expectation) ToBeLessThan(n any, msg ...string) *Expectation {
+	e.r.T.Helper()
+	e.order(n, -1, "less than", msg)
+	return e
+}
+
+// order compares the expectation value against n and checks the result
+// matches want (1 for greater, -1 for less).
+func (e *Expectation) order(n any, want int, relation string, msg []string) {
+	e.r.T.Helper()
+	c, ok := compareOrdered(e.value, n)
+	if !ok {
+		e.r.Fail("Cannot compare %v (%T) with %v (%T)", e.value, e.value, n, n)
+		return
+	}
+	e.r.report(check{
+		ok:      c == want,
+		pass:    fmt.Sprintf("%v is %s %v", e.value, relation, n),
+		fail:    fmt.Sprintf("Expected %v to be %s %v", e.value, relation, n),
+		notPass: fmt.Sprintf("%v is not %s %v", e.value, relation, n),
+		notFail: fmt.Sprintf("Expected %v not to be %s %v", e.value, relation, n),
+	}, msg)
+}
+
+// compareOrdered compares two numbers or two strings, returning -1, 0 or 1.
+// The second result is false if the values cannot be compared.
+func compareOrdered(a, b any) (int, bool) {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() {
+		return 0, false
+	}
+	switch {
+	case isInt(av) && isInt(bv):
+		return cmp.Compare(av.Int(), bv.Int()), true
+	case isUint(av) && isUint(bv):
+		return cmp.Compare(av.Uint(), bv.Uint()), true
+	case isNumber(av) && isNumber(bv):
+		return cmp.Compare(toFloat(av), toFloat(bv)), true
+	case av.Kind() == reflect.String && bv.Kind() == reflect.String:
+		return cmp.Compare(av.String(), bv.String()), true
+	}
+	return 0, false
+}
+
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUint(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isNumber(v reflect.Value) bool {
+	return isInt(v) || isUint(v) || v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case isInt(v):
+		return float64(v.Int())
+	case isUint(v):
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
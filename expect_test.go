@@ -0,0 +1,28 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestExpect tests the fluent expectation matchers
+func TestExpect(t *testing.T) {
+	r := got.New(t, "Test Expect")
+
+	r.Case("Testing passing matchers")
+	r.Expect(5).ToEqual(5).ToNotEqual(6).ToBeGreaterThan(3).ToBeLessThan(10)
+	r.Expect(nil).ToBeNil()
+	r.Expect("hello").ToNotBeNil().ToContain("ell")
+	r.Expect([]int{1, 2, 3}).ToContain(2)
+	r.Expect(2.5).ToBeGreaterThan(2)
+	r.Expect(uint(3)).ToBeLessThan(uint(4))
+	r.Expect("b").ToBeGreaterThan("a")
+
+	r.Case("Testing failing matchers")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Expect(1).ToEqual(2) }), "ToEqual should fail for different values")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Expect(1).ToBeGreaterThan(2) }), "ToBeGreaterThan should fail for smaller values")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Expect(2).ToBeLessThan(2) }), "ToBeLessThan should fail for equal values")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Expect("a").ToBeGreaterThan(1) }), "Ordering should fail for incomparable values")
+}
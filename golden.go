@@ -0,0 +1,83 @@
+package got
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/go4x/got/internal/textdiff"
+)
+
+// update is set by the -got.update flag of go test to rewrite golden files
+// instead of comparing against them. The flag is namespaced so that it does not
+// clash with an -update flag defined by the test package.
+var update = flag.Bool("got.update", false, "rewrite golden files used by AssertMatchesJSONFile and SnapshotJSON")
+
+// AssertMatchesJSONFile asserts that value, marshaled to JSON, is semantically
+// equal to the JSON in the golden file at path: formatting and key order are
+// ignored. On mismatch, a diff of the indented JSON documents is reported.
+//
+// Run the tests with the -got.update flag, as in go test -got.update, to write
+// value to the file instead, creating it and its directory if needed.
+//
+// Example:
+//
+//	r.AssertMatchesJSONFile(resp, "testdata/get_user.golden.json")
+func (r *R) AssertMatchesJSONFile(value any, path string, msg ...string) *R {
+	r.T.Helper()
+	actual, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		r.Fail("Cannot marshal %T to JSON: %v", value, err)
+		return r
+	}
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			r.Fatal("Failed to create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, append(actual, '\n'), 0o644); err != nil {
+			r.Fatal("Failed to update golden file %s: %v", path, err)
+		}
+		r.Logf("Updated golden file %s", path)
+		return r
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		r.Fail("Cannot read golden file %s (run go test -got.update to create it): %v", path, err)
+		return r
+	}
+	var want, have any
+	if err := json.Unmarshal(data, &want); err != nil {
+		r.Fail("Invalid JSON in golden file %s: %v", path, err)
+		return r
+	}
+	// Decode the marshaled value again so both sides use the same types, and
+	// format both the same way for the diff.
+	json.Unmarshal(actual, &have)
+	expected, _ := json.MarshalIndent(want, "", "  ")
+	actual, _ = json.MarshalIndent(have, "", "  ")
+	r.report(check{
+		ok:      reflect.DeepEqual(want, have),
+		pass:    "Value matches golden file " + path,
+		fail:    "Value does not match golden file " + path + " (- golden, + actual):\n" + textdiff.Lines(string(expected), string(actual)),
+		notPass: "Value does not match golden file " + path,
+		notFail: "Expected value not to match golden file " + path,
+	}, msg)
+	return r
+}
+
+// SnapshotJSON asserts that value matches the snapshot stored in
+// testdata/<name>.json, like AssertMatchesJSONFile: value is marshaled as
+// indented JSON, with map keys in sorted order, and a line diff is reported
+// on mismatch. Run the tests with -got.update to record the snapshot. name may
+// contain slashes to group snapshots in subdirectories.
+//
+// Example:
+//
+//	r.SnapshotJSON("get_user", resp)
+func (r *R) SnapshotJSON(name string, value any, msg ...string) *R {
+	r.T.Helper()
+	return r.AssertMatchesJSONFile(value, filepath.Join("testdata", filepath.FromSlash(name)+".json"), msg...)
+}
@@ -0,0 +1,77 @@
+package got_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// update is the flag test packages commonly define for their own golden
+// files; it must not clash with the flag registered by got.
+var update = flag.Bool("update", false, "rewrite the golden files of this package")
+
+type goldenUser struct {
+	ID   int      `json:"id"`
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// TestAssertMatchesJSONFile tests comparing values against golden JSON files
+func TestAssertMatchesJSONFile(t *testing.T) {
+	r := got.New(t, "Test AssertMatchesJSONFile")
+	user := goldenUser{ID: 1, Name: "alice", Tags: []string{"admin"}}
+
+	r.Case("Testing semantically equal JSON")
+	path := r.TempFile("user-*.json", []byte(`{"tags":["admin"],"name":"alice","id":1}`))
+	r.AssertMatchesJSONFile(user, path)
+
+	r.Case("Testing mismatching JSON")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertMatchesJSONFile(goldenUser{ID: 2, Name: "alice"}, path)
+	}), "different value should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertMatchesJSONFile(user, filepath.Join(r.TempDir(), "missing.json"))
+	}), "missing golden file should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertMatchesJSONFile(user, r.TempFile("bad-*.json", []byte("{")))
+	}), "invalid golden file should fail")
+
+	r.Case("Testing updating golden files")
+	r.AssertNoErr(flag.Set("got.update", "true"))
+	r.Cleanup(func() { flag.Set("got.update", "false") })
+	newPath := filepath.Join(r.TempDir(), "golden", "user.json")
+	r.AssertMatchesJSONFile(user, newPath)
+	data, err := os.ReadFile(newPath)
+	r.AssertNoErr(err)
+	r.AssertContains(string(data), `"name": "alice"`)
+	r.AssertNoErr(flag.Set("got.update", "false"))
+	r.AssertMatchesJSONFile(user, newPath)
+}
+
+// TestSnapshotJSON tests recording and comparing JSON snapshots in testdata
+func TestSnapshotJSON(t *testing.T) {
+	r := got.New(t, "Test SnapshotJSON")
+	dir := r.TempDir()
+	r.Chdir(dir)
+	value := map[string]any{"zeta": 1, "alpha": []int{1, 2}, "user": goldenUser{ID: 1, Name: "alice"}}
+
+	r.Case("Testing recording snapshots")
+	r.AssertNoErr(flag.Set("got.update", "true"))
+	r.Cleanup(func() { flag.Set("got.update", "false") })
+	r.SnapshotJSON("users/alice", value)
+	r.AssertNoErr(flag.Set("got.update", "false"))
+	data, err := os.ReadFile(filepath.Join(dir, "testdata", "users", "alice.json"))
+	r.AssertNoErr(err)
+	r.AssertContainsInOrder(string(data), `"alpha": [`, `"user": {`, `"zeta": 1`)
+
+	r.Case("Testing comparing snapshots")
+	r.SnapshotJSON("users/alice", value)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.SnapshotJSON("users/alice", map[string]any{"zeta": 2})
+	}), "different value should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.SnapshotJSON("missing", value) }), "missing snapshot should fail")
+}
@@ -0,0 +1,82 @@
+package got
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// goroutineGroup tracks the goroutines started with Go until Wait.
+type goroutineGroup struct {
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started int
+	panics  []string
+}
+
+// Go runs fn in a new goroutine, recovering any panic. A panic in a goroutine
+// started with the go statement crashes the whole test binary, or is lost if
+// the code under test recovers it badly; with Go, it is recorded instead, and
+// the next call to Wait fails the test with the panic value and stack.
+//
+// Parameters:
+//   - fn: The function to run in the goroutine
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Go(func() { worker.Process(job1) })
+//	r.Go(func() { worker.Process(job2) })
+//	r.Wait()
+func (r *R) Go(fn func()) *R {
+	g := r.root().spawned
+	g.mu.Lock()
+	g.started++
+	n := g.started
+	g.mu.Unlock()
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() {
+			if v := recover(); v != nil {
+				g.mu.Lock()
+				g.panics = append(g.panics, fmt.Sprintf("goroutine %d panicked: %v\n%s", n, v, debug.Stack()))
+				g.mu.Unlock()
+			}
+		}()
+		fn()
+	}()
+	return r
+}
+
+// Wait waits for the goroutines started with Go since the last call to Wait
+// and fails the test if any of them panicked, reporting every panic value and
+// stack.
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+func (r *R) Wait() *R {
+	r.T.Helper()
+	g := r.root().spawned
+	g.wg.Wait()
+	g.mu.Lock()
+	started, panics := g.started, g.panics
+	g.started, g.panics = 0, nil
+	g.mu.Unlock()
+
+	if r.report(check{
+		ok:      len(panics) == 0,
+		pass:    fmt.Sprintf("%d goroutines completed without panicking", started),
+		fail:    fmt.Sprintf("Expected no panics, but %d of %d goroutines panicked", len(panics), started),
+		notPass: fmt.Sprintf("%d of %d goroutines panicked", len(panics), started),
+		notFail: fmt.Sprintf("Expected a panic, but %d goroutines completed without panicking", started),
+	}, nil) {
+		return r
+	}
+	for _, p := range panics {
+		r.Log(p)
+	}
+	return r
+}
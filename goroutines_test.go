@@ -0,0 +1,89 @@
+package got_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestGoWait tests recovering and reporting the panics of goroutines
+func TestGoWait(t *testing.T) {
+	r := got.New(t, "Test Go and Wait")
+
+	r.Case("Testing goroutines without panics")
+	var n atomic.Int32
+	for i := 0; i < 5; i++ {
+		r.Go(func() { n.Add(1) })
+	}
+	r.Wait()
+	r.AssertEqual(int32(5), n.Load())
+
+	r.Case("Testing panicking goroutines")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Go(func() {}).Go(func() { panic("boom") }).Wait()
+	}), "a panicking goroutine should fail the test")
+
+	_, out := gottest.Detached(func(pr *got.R) {
+		pr.Go(func() { panic("first") }).Go(func() { panic("second") }).Wait()
+	})
+	r.AssertContains(out, "2 of 2 goroutines panicked")
+	r.AssertTrue(strings.Contains(out, "panicked: first") && strings.Contains(out, "panicked: second"), out)
+	r.AssertContains(out, "goroutines_test.go", "the stack should be reported")
+
+	r.Case("Testing negation")
+	r.Not().Go(func() { panic("boom") }).Wait()
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().Go(func() {}).Wait() }), "negated check without a panic should fail")
+
+	r.Case("Testing that Wait resets the panics")
+	r.AssertFalse(gottest.Probe(func(pr *got.R) {
+		pr.Go(func() {}).Wait()
+		pr.Go(func() {}).Wait()
+	}))
+}
+
+// TestGoWaitSubtests tests that subtests wait for their own goroutines only.
+// The parallel subtests overlap when run with -parallel above 1; the nested
+// subtest checks the same isolation whatever the parallelism.
+func TestGoWaitSubtests(t *testing.T) {
+	r := got.New(t, "Test Go and Wait in subtests")
+	waitAlone := func(sr *got.R) {
+		start := time.Now()
+		sr.Not().Go(func() { panic("boom in panicking") }).Wait()
+		sr.AssertTrue(time.Since(start) < 500*time.Millisecond, "Wait should not wait for the goroutines of other subtests")
+	}
+
+	r.Run("nested", func(tt *testing.T) {
+		sr := r.Sub(tt)
+		released := make(chan struct{})
+		sr.Go(func() {
+			select {
+			case <-released:
+			case <-time.After(time.Second):
+			}
+		})
+		sr.Run("inner", func(it *testing.T) { waitAlone(sr.Sub(it)) })
+		close(released)
+		sr.Wait()
+	})
+
+	// The slow goroutine runs until the other subtest has waited for its own.
+	released := make(chan struct{})
+	r.Run("slow", func(tt *testing.T) {
+		tt.Parallel()
+		r.Sub(tt).Go(func() {
+			select {
+			case <-released:
+			case <-time.After(time.Second):
+			}
+		}).Wait()
+	})
+	r.Run("panicking", func(tt *testing.T) {
+		tt.Parallel()
+		waitAlone(r.Sub(tt))
+		close(released)
+	})
+}
@@ -0,0 +1,196 @@
+package gothttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/textdiff"
+)
+
+// R is a test runner with helpers for testing HTTP handlers.
+// It embeds *got.R, so all the assertions of the core runner are available.
+// The HTTP assertions report as written: Not returns a *got.R, which does not
+// have them, and they are not inverted by it.
+//
+// Example:
+//
+//	r := gothttp.New(t, "User API")
+//	rec := r.ServeHTTP(handler, httptest.NewRequest("GET", "/users/1", nil))
+//	r.AssertStatus(rec, http.StatusOK).
+//		AssertHeader(rec, "Content-Type", "application/json")
+type R struct {
+	*got.R
+}
+
+// New creates a new HTTP test runner, like got.New.
+func New(t *testing.T, title string) *R {
+	t.Helper()
+	return &R{R: got.New(t, title)}
+}
+
+// Wrap extends an existing runner with the HTTP helpers.
+func Wrap(r *got.R) *R {
+	r.T.Helper()
+	return &R{R: r}
+}
+
+// ServeHTTP serves req with handler and returns the recorded response.
+func (r *R) ServeHTTP(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	r.T.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	r.Logf("%s %s -> %d", req.Method, req.URL, rec.Code)
+	return rec
+}
+
+// AssertStatus asserts that the recorded response has the expected status code.
+// On failure, the response body is reported to help diagnose the error.
+func (r *R) AssertStatus(rec *httptest.ResponseRecorder, code int, msg ...string) *R {
+	r.T.Helper()
+	if rec.Code != code {
+		message := fmt.Sprintf("Expected status %d, got %d with body %q", code, rec.Code, rec.Body.String())
+		if len(msg) > 0 {
+			message = msg[0]
+		}
+		r.Fail(message)
+	} else {
+		r.Pass("Status is %d", code)
+	}
+	return r
+}
+
+// AssertHeader asserts that the recorded response has a header key whose
+// first value equals value.
+func (r *R) AssertHeader(rec *httptest.ResponseRecorder, key, value string, msg ...string) *R {
+	r.T.Helper()
+	actual, ok := rec.Header()[http.CanonicalHeaderKey(key)]
+	if !ok || len(actual) == 0 || actual[0] != value {
+		message := fmt.Sprintf("Expected header %s to be %q, got %q", key, value, rec.Header().Get(key))
+		if !ok {
+			message = fmt.Sprintf("Expected header %s to be %q, but it is missing", key, value)
+		}
+		if len(msg) > 0 {
+			message = msg[0]
+		}
+		r.Fail(message)
+	} else {
+		r.Pass("Header %s is %q", key, value)
+	}
+	return r
+}
+
+// AssertJSONBody asserts that the recorded response body is JSON that decodes
+// to a value deeply equal to expected. The body is decoded into a new value of
+// the same type as expected, so object key order does not matter. Empty bodies
+// and responses whose Content-Type is not JSON fail with a dedicated message.
+// A mismatch shows a line diff of both values as indented JSON.
+//
+// Example:
+//
+//	r.AssertJSONBody(rec, User{ID: 1, Name: "alice"})
+//	r.AssertJSONBody(rec, map[string]any{"ok": true})
+func (r *R) AssertJSONBody(rec *httptest.ResponseRecorder, expected any, msg ...string) *R {
+	r.T.Helper()
+	if expected == nil {
+		r.Fail("Expected value for the JSON body must not be nil")
+		return r
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "" && !isJSONContentType(ct) {
+		r.Fail("Expected a JSON response, but Content-Type is %q", ct)
+		return r
+	}
+	body := rec.Body.Bytes()
+	if len(bytes.TrimSpace(body)) == 0 {
+		r.Fail("Expected a JSON body, but the response body is empty")
+		return r
+	}
+	actual := reflect.New(reflect.TypeOf(expected))
+	if err := json.Unmarshal(body, actual.Interface()); err != nil {
+		r.Fail("Expected a JSON body, but it cannot be decoded into %T: %v", expected, err)
+		return r
+	}
+	if !reflect.DeepEqual(expected, actual.Elem().Interface()) {
+		diff := textdiff.Lines(prettyJSON(expected), prettyJSON(actual.Elem().Interface()))
+		message := "Expected JSON body to match (- expected, + actual):\n\t" + strings.ReplaceAll(diff, "\n", "\n\t")
+		if len(msg) > 0 {
+			message = msg[0]
+		}
+		r.Fail(message)
+	} else {
+		r.Pass("JSON body matches the expected value")
+	}
+	return r
+}
+
+// isJSONContentType reports whether the media type of ct is JSON.
+func isJSONContentType(ct string) bool {
+	mt, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// prettyJSON formats v as indented JSON for failure messages.
+func prettyJSON(v any) string {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// AssertCookie asserts that the recorded response sets a cookie named name
+// for which match returns true. A nil match only checks that the cookie is
+// set. If several Set-Cookie headers use the name, one of them must match.
+// On failure, the cookies set by the response are listed.
+//
+// Example:
+//
+//	r.AssertCookie(rec, "session", func(c *http.Cookie) bool {
+//		return c.HttpOnly && c.Secure && c.Value != ""
+//	})
+func (r *R) AssertCookie(rec *httptest.ResponseRecorder, name string, match func(*http.Cookie) bool, msg ...string) *R {
+	r.T.Helper()
+	cookies := rec.Result().Cookies()
+	found := false
+	for _, c := range cookies {
+		if c.Name != name {
+			continue
+		}
+		found = true
+		if match == nil || match(c) {
+			r.Pass("Cookie %s is set: %s", name, c)
+			return r
+		}
+	}
+	message := fmt.Sprintf("Expected cookie %s to be set, got cookies: %s", name, describeCookies(cookies))
+	if found {
+		message = fmt.Sprintf("Expected cookie %s to match, got cookies: %s", name, describeCookies(cookies))
+	}
+	if len(msg) > 0 {
+		message = msg[0]
+	}
+	r.Fail(message)
+	return r
+}
+
+// describeCookies formats cookies as Set-Cookie values for failure messages.
+func describeCookies(cookies []*http.Cookie) string {
+	if len(cookies) == 0 {
+		return "none"
+	}
+	s := make([]string, len(cookies))
+	for i, c := range cookies {
+		s[i] = c.String()
+	}
+	return strings.Join(s, "; ")
+}
@@ -0,0 +1,101 @@
+package gothttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// probe reports whether fn fails on a runner detached from the running test.
+func probe(fn func(r *R)) bool {
+	return gottest.Probe(func(r *got.R) { fn(Wrap(r)) })
+}
+
+func helloHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte("hello"))
+}
+
+// TestServeHTTP tests serving requests and asserting on the response
+func TestServeHTTP(t *testing.T) {
+	r := New(t, "Test ServeHTTP")
+
+	r.Case("Testing status and header assertions")
+	rec := r.ServeHTTP(http.HandlerFunc(helloHandler), httptest.NewRequest(http.MethodGet, "/hello", nil))
+	r.AssertStatus(rec, http.StatusCreated).
+		AssertHeader(rec, "content-type", "text/plain")
+	r.AssertEqual("hello", rec.Body.String())
+
+	r.Case("Testing failing assertions")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertStatus(rec, http.StatusOK) }), "wrong status should fail")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertHeader(rec, "Content-Type", "application/json") }), "wrong header should fail")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertHeader(rec, "X-Missing", "") }), "missing header should fail")
+}
+
+// TestWrap tests extending an existing runner
+func TestWrap(t *testing.T) {
+	base := got.New(t, "Test Wrap")
+	r := Wrap(base)
+	r.AssertTrue(r.R == base, "Wrap should reuse the given runner")
+}
+
+// TestAssertJSONBody tests asserting on JSON response bodies
+func TestAssertJSONBody(t *testing.T) {
+	r := New(t, "Test AssertJSONBody")
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	jsonHandler := func(body string, ct string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if ct != "" {
+				w.Header().Set("Content-Type", ct)
+			}
+			_, _ = w.Write([]byte(body))
+		})
+	}
+	get := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+
+	r.Case("Testing matching bodies")
+	rec := r.ServeHTTP(jsonHandler(`{"name":"alice","id":1}`, "application/json; charset=utf-8"), get)
+	r.AssertJSONBody(rec, user{ID: 1, Name: "alice"})
+	r.AssertJSONBody(rec, map[string]any{"id": 1.0, "name": "alice"})
+
+	r.Case("Testing mismatching bodies")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertJSONBody(rec, user{ID: 2, Name: "alice"}) }), "different value should fail")
+	_, out := gottest.Detached(func(pr *got.R) { Wrap(pr).AssertJSONBody(rec, user{ID: 2, Name: "alice"}) })
+	r.AssertTrue(strings.Contains(out, "\t-   \"id\": 2,\n\t+   \"id\": 1,\n\t    \"name\": \"alice\"\n"), "the failure should show a line diff, got:\n"+out)
+	empty := r.ServeHTTP(jsonHandler("", "application/json"), get)
+	r.AssertTrue(probe(func(pr *R) { pr.AssertJSONBody(empty, user{}) }), "empty body should fail")
+	text := r.ServeHTTP(jsonHandler(`{"id":1}`, "text/plain"), get)
+	r.AssertTrue(probe(func(pr *R) { pr.AssertJSONBody(text, user{ID: 1}) }), "non-JSON content type should fail")
+	broken := r.ServeHTTP(jsonHandler(`{"id":`, "application/json"), get)
+	r.AssertTrue(probe(func(pr *R) { pr.AssertJSONBody(broken, user{}) }), "malformed JSON should fail")
+}
+
+// TestAssertCookie tests asserting on cookies set by the response
+func TestAssertCookie(t *testing.T) {
+	r := New(t, "Test AssertCookie")
+	login := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "theme", Value: "dark"})
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc123", HttpOnly: true, Secure: true})
+	})
+	rec := r.ServeHTTP(login, httptest.NewRequest(http.MethodPost, "/login", nil))
+
+	r.Case("Testing matching cookies")
+	r.AssertCookie(rec, "session", func(c *http.Cookie) bool {
+		return c.HttpOnly && c.Secure && c.Value == "abc123"
+	})
+	r.AssertCookie(rec, "theme", nil)
+
+	r.Case("Testing failing cookie assertions")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertCookie(rec, "missing", nil) }), "missing cookie should fail")
+	r.AssertTrue(probe(func(pr *R) {
+		pr.AssertCookie(rec, "theme", func(c *http.Cookie) bool { return c.Value == "light" })
+	}), "non-matching cookie should fail")
+}
@@ -0,0 +1,154 @@
+package gothttp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// MockTransport is an http.RoundTripper that records the requests it receives
+// and answers them with canned responses, so that HTTP clients can be tested
+// without a live server. It is safe for concurrent use. Create it with
+// R.NewMockTransport and install it with &http.Client{Transport: mock}.
+//
+// Example:
+//
+//	mock := r.NewMockTransport().
+//		Respond(http.MethodGet, "https://api.example.com/users/1", http.StatusOK, `{"id": 1}`)
+//	client := &http.Client{Transport: mock}
+//	user, err := NewAPI(client).GetUser(1)
+//	mock.AssertRequest(http.MethodGet, "https://api.example.com/users/1", nil)
+type MockTransport struct {
+	r         *R
+	mu        sync.Mutex
+	responses map[string]cannedResponse
+	requests  []*http.Request
+}
+
+// cannedResponse is the response configured for a method and URL.
+type cannedResponse struct {
+	status int
+	body   string
+	header http.Header
+}
+
+// NewMockTransport creates a transport reporting its assertions through the
+// runner.
+func (r *R) NewMockTransport() *MockTransport {
+	return &MockTransport{r: r, responses: map[string]cannedResponse{}}
+}
+
+// Respond configures the response to requests with the given method and URL.
+// The URL is compared with the full request URL, including the query string.
+// Requests without a configured response fail with an error.
+func (m *MockTransport) Respond(method, url string, status int, body string) *MockTransport {
+	return m.RespondWithHeader(method, url, status, body, nil)
+}
+
+// RespondWithHeader is like Respond, but also sets the response headers.
+func (m *MockTransport) RespondWithHeader(method, url string, status int, body string, header http.Header) *MockTransport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses[requestKey(method, url)] = cannedResponse{status: status, body: body, header: header}
+	return m
+}
+
+// RoundTrip records a copy of req and returns the response configured for its
+// method and URL. The request body is read into the copy, so it can still be
+// inspected through Requests; req itself is not modified.
+func (m *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.r.T.Helper()
+	rec := req.Clone(req.Context())
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("mock transport: reading request body: %v", err)
+		}
+		rec.Body = io.NopCloser(bytes.NewReader(body))
+		rec.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+	m.mu.Lock()
+	m.requests = append(m.requests, rec)
+	resp, ok := m.responses[requestKey(req.Method, req.URL.String())]
+	m.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("mock transport: no response configured for %s %s", req.Method, req.URL)
+	}
+	header := resp.header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.status, http.StatusText(resp.status)),
+		StatusCode:    resp.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(resp.body)),
+		ContentLength: int64(len(resp.body)),
+		Request:       req,
+	}, nil
+}
+
+// Requests returns the recorded requests, in order.
+func (m *MockTransport) Requests() []*http.Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*http.Request(nil), m.requests...)
+}
+
+// AssertRequest asserts that a request with the given method and URL was made
+// for which match returns true. A nil match only checks that the request was
+// made. On failure, the recorded requests are listed.
+//
+// Example:
+//
+//	mock.AssertRequest(http.MethodPost, "https://api.example.com/users", func(req *http.Request) bool {
+//		return req.Header.Get("Authorization") == "Bearer token"
+//	})
+func (m *MockTransport) AssertRequest(method, url string, match func(*http.Request) bool, msg ...string) *MockTransport {
+	m.r.T.Helper()
+	requests := m.Requests()
+	found := false
+	for _, req := range requests {
+		if req.Method != method || req.URL.String() != url {
+			continue
+		}
+		found = true
+		if match == nil || match(req) {
+			m.r.Pass("Request %s %s was made", method, url)
+			return m
+		}
+	}
+	message := fmt.Sprintf("Expected request %s %s, got requests: %s", method, url, describeRequests(requests))
+	if found {
+		message = fmt.Sprintf("Expected request %s %s to match, got requests: %s", method, url, describeRequests(requests))
+	}
+	if len(msg) > 0 {
+		message = msg[0]
+	}
+	m.r.Fail(message)
+	return m
+}
+
+// requestKey identifies the canned response for a method and URL.
+func requestKey(method, url string) string {
+	return method + " " + url
+}
+
+// describeRequests formats requests for failure messages.
+func describeRequests(requests []*http.Request) string {
+	if len(requests) == 0 {
+		return "none"
+	}
+	s := make([]string, len(requests))
+	for i, req := range requests {
+		s[i] = req.Method + " " + req.URL.String()
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,62 @@
+package gothttp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestMockTransport tests recording requests and returning canned responses
+func TestMockTransport(t *testing.T) {
+	r := New(t, "Test MockTransport")
+	const usersURL = "https://api.example.com/users"
+	mock := r.NewMockTransport().
+		Respond(http.MethodGet, usersURL+"/1", http.StatusOK, `{"id": 1}`).
+		RespondWithHeader(http.MethodPost, usersURL, http.StatusCreated, "", http.Header{"Location": {usersURL + "/2"}})
+	client := &http.Client{Transport: mock}
+
+	r.Case("Testing canned responses")
+	resp, err := client.Get(usersURL + "/1")
+	r.AssertNoErr(err)
+	body, _ := io.ReadAll(resp.Body)
+	r.AssertEqual(http.StatusOK, resp.StatusCode).AssertEqual(`{"id": 1}`, string(body))
+
+	req, _ := http.NewRequest(http.MethodPost, usersURL, strings.NewReader(`{"name": "bob"}`))
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err = client.Do(req)
+	r.AssertNoErr(err)
+	r.AssertEqual(http.StatusCreated, resp.StatusCode).AssertEqual(usersURL+"/2", resp.Header.Get("Location"))
+
+	_, err = client.Get(usersURL + "/3")
+	r.AssertErr(err)
+
+	r.Case("Testing request assertions")
+	mock.AssertRequest(http.MethodGet, usersURL+"/1", nil).
+		AssertRequest(http.MethodPost, usersURL, func(req *http.Request) bool {
+			body, _ := io.ReadAll(req.Body)
+			return req.Header.Get("Authorization") == "Bearer token" && string(body) == `{"name": "bob"}`
+		})
+	r.AssertEqual(3, len(mock.Requests()))
+
+	r.Case("Testing the request passed to RoundTrip is not modified")
+	payload := io.NopCloser(strings.NewReader("payload"))
+	req, _ = http.NewRequest(http.MethodPost, usersURL, payload)
+	_, err = mock.RoundTrip(req)
+	r.AssertNoErr(err)
+	r.AssertTrue(req.Body == payload && req.GetBody == nil, "RoundTrip should leave the request untouched")
+	recorded := mock.Requests()[3]
+	r.AssertTrue(recorded != req, "RoundTrip should record a copy of the request")
+	data, _ := io.ReadAll(recorded.Body)
+	r.AssertEqual("payload", string(data))
+
+	r.Case("Testing failing request assertions")
+	r.AssertTrue(probe(func(pr *R) {
+		pr.NewMockTransport().AssertRequest(http.MethodGet, usersURL, nil)
+	}), "missing request should fail")
+	r.AssertTrue(probe(func(pr *R) {
+		m := pr.NewMockTransport().Respond(http.MethodGet, usersURL, http.StatusOK, "")
+		(&http.Client{Transport: m}).Get(usersURL)
+		m.AssertRequest(http.MethodGet, usersURL, func(*http.Request) bool { return false })
+	}), "non-matching request should fail")
+}
@@ -0,0 +1,89 @@
+package got
+
+// caseHooks holds the callbacks registered with BeforeEach and AfterEach and
+// which nesting levels currently have an open case.
+type caseHooks struct {
+	before []func()
+	after  []func()
+	open   []bool // open[depth] is set while a case started at depth runs
+}
+
+// BeforeEach registers fn to run at the start of every case started with
+// Case, Caser or Cases, including the cases nested in subtests. Callbacks
+// registered with several calls run in registration order.
+//
+// Parameters:
+//   - fn: The function to run before each case
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.BeforeEach(func() { mock.Reset() })
+func (r *R) BeforeEach(fn func()) *R {
+	h := r.caseHooks()
+	h.before = append(h.before, fn)
+	return r
+}
+
+// AfterEach registers fn to run at the end of every case started with Case,
+// Caser or Cases, even if the case failed. A case started with Caser or Cases
+// ends when its subtest returns; a case started with Case ends when the next
+// case at the same level starts, or when the enclosing subtest or test ends.
+// Callbacks registered with several calls run in reverse registration order,
+// like Cleanup.
+//
+// Parameters:
+//   - fn: The function to run after each case
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.AfterEach(func() { r.AssertNoErr(mock.ExpectationsWereMet()) })
+func (r *R) AfterEach(fn func()) *R {
+	h := r.caseHooks()
+	h.after = append(h.after, fn)
+	return r
+}
+
+// caseHooks returns the hooks of the runner, creating them on first use.
+func (r *R) caseHooks() *caseHooks {
+	root := r.root()
+	if root.hooks == nil {
+		root.hooks = &caseHooks{}
+		root.T.Cleanup(func() { root.endCase(0) })
+	}
+	return root.hooks
+}
+
+// startCase ends the open case at the current level, if any, and runs the
+// BeforeEach callbacks for a new one.
+func (r *R) startCase() {
+	h := r.root().hooks
+	if h == nil {
+		return
+	}
+	r.endCase(r.depth)
+	for len(h.open) <= r.depth {
+		h.open = append(h.open, false)
+	}
+	h.open[r.depth] = true
+	for _, fn := range h.before {
+		fn()
+	}
+}
+
+// endCase runs the AfterEach callbacks if a case is open at depth.
+func (r *R) endCase(depth int) {
+	h := r.root().hooks
+	if h == nil || depth >= len(h.open) || !h.open[depth] {
+		return
+	}
+	h.open[depth] = false
+	for i := len(h.after) - 1; i >= 0; i-- {
+		h.after[i]()
+	}
+}
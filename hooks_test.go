@@ -0,0 +1,41 @@
+package got_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// TestBeforeAfterEach tests the hooks run around each case
+func TestBeforeAfterEach(t *testing.T) {
+	var events []string
+	record := func(e string) func() { return func() { events = append(events, e) } }
+
+	t.Run("hooks", func(t *testing.T) {
+		r := got.New(t, "Test BeforeAfterEach")
+		r.BeforeEach(record("b1")).BeforeEach(record("b2"))
+		r.AfterEach(record("a1")).AfterEach(record("a2"))
+
+		r.Case("first")
+		events = append(events, "first")
+		r.Caser("second", func(tt *testing.T) {
+			events = append(events, "second")
+			r.Case("nested")
+			events = append(events, "nested")
+		})
+		r.Cases([]got.Case{got.NewCase("row", nil, nil, false, nil)}, func(c got.Case, tt *testing.T) {
+			events = append(events, "row")
+			tt.SkipNow()
+		})
+		r.Case("last")
+	})
+
+	want := "b1 b2 first a2 a1 " +
+		"b1 b2 second b1 b2 nested a2 a1 a2 a1 " +
+		"b1 b2 row a2 a1 " +
+		"b1 b2 a2 a1"
+	if got := strings.Join(events, " "); got != want {
+		t.Errorf("Expected hook order %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,35 @@
+// Package gottest provides the helpers used by the tests of got and its
+// subpackages to exercise assertions that fail.
+package gottest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// Detached runs fn against a runner that is not attached to the running test,
+// so that fn can fail without failing it. It reports whether fn failed and
+// returns the lines logged by the runner, without color. fn runs on its own
+// goroutine, so FailNow and Fatal stop fn only.
+//
+// The runner's testing.T is not created by the testing package: its Context
+// is nil and its Cleanup callbacks never run, so fn must not depend on them.
+func Detached(fn func(r *got.R), opts ...got.Option) (failed bool, output string) {
+	var buf bytes.Buffer
+	t := &testing.T{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(got.New(t, "detached", append([]got.Option{got.WithReporter(&buf), got.WithColor(false)}, opts...)...))
+	}()
+	<-done
+	return t.Failed(), buf.String()
+}
+
+// Probe reports whether fn fails when run by Detached.
+func Probe(fn func(r *got.R)) bool {
+	failed, _ := Detached(fn)
+	return failed
+}
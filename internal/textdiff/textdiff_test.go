@@ -0,0 +1,20 @@
+package textdiff
+
+import "testing"
+
+// TestLines tests the line-by-line diff of failure messages
+func TestLines(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"a\nb\nc", "a\nb\nc", "  a\n  b\n  c"},
+		{"a\nb\nc", "a\nx\nc", "  a\n- b\n+ x\n  c"},
+		{"a\nc", "a\nb\nc", "  a\n+ b\n  c"},
+		{"a\nb", "b", "- a\n  b"},
+	}
+	for _, tt := range tests {
+		if got := Lines(tt.a, tt.b); got != tt.want {
+			t.Errorf("Lines(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package got
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// AssertJSONShape asserts that the JSON document actual has the same shape as
+// the template expected: the same object keys, recursively, with values of the
+// same JSON kind (object, array, string, number, boolean or null). Concrete
+// values are ignored. Every element of an array in actual is checked against
+// the first element of the corresponding template array; an empty template
+// array accepts any elements. On failure, missing and extra keys and kind
+// mismatches are reported with their paths.
+//
+// Example:
+//
+//	r.AssertJSONShape(`{"id": 0, "name": "", "tags": [""]}`, rec.Body.String())
+func (r *R) AssertJSONShape(expected, actual string, msg ...string) *R {
+	r.T.Helper()
+	var ev, av any
+	if err := json.Unmarshal([]byte(expected), &ev); err != nil {
+		r.Fail("Invalid expected JSON: %v", err)
+		return r
+	}
+	if err := json.Unmarshal([]byte(actual), &av); err != nil {
+		r.Fail("Invalid actual JSON: %v", err)
+		return r
+	}
+	var diffs []string
+	shapeDiff("$", ev, av, &diffs)
+	r.report(check{
+		ok:      len(diffs) == 0,
+		pass:    "JSON has the expected shape",
+		fail:    fmt.Sprintf("Expected JSON to have the shape of %s, but:\n\t%s", expected, strings.Join(diffs, "\n\t")),
+		notPass: "JSON does not have the expected shape",
+		notFail: fmt.Sprintf("Expected JSON not to have the shape of %s", expected),
+	}, msg)
+	return r
+}
+
+// AssertJSONArrayUnordered asserts that the JSON arrays expected and actual
+// hold the same elements with the same multiplicities, in any order. Elements
+// are compared structurally, so key order and formatting inside them do not
+// matter. On failure, the elements missing from actual and the extra elements
+// in actual are reported.
+//
+// Example:
+//
+//	r.AssertJSONArrayUnordered(`[{"id": 1}, {"id": 2}]`, rec.Body.String())
+func (r *R) AssertJSONArrayUnordered(expected, actual string, msg ...string) *R {
+	r.T.Helper()
+	var ev, av []any
+	if err := json.Unmarshal([]byte(expected), &ev); err != nil {
+		r.Fail("Invalid expected JSON array: %v", err)
+		return r
+	}
+	if err := json.Unmarshal([]byte(actual), &av); err != nil {
+		r.Fail("Invalid actual JSON array: %v", err)
+		return r
+	}
+	missing, extra := multisetDiff(reflect.ValueOf(ev), reflect.ValueOf(av))
+	r.report(check{
+		ok:      len(missing) == 0 && len(extra) == 0,
+		pass:    fmt.Sprintf("JSON arrays hold the same %d elements", len(av)),
+		fail:    fmt.Sprintf("Expected JSON array %s in any order, got %s; missing: %s, extra: %s", expected, actual, compactJSON(missing), compactJSON(extra)),
+		notPass: "JSON arrays hold different elements",
+		notFail: fmt.Sprintf("Expected JSON arrays %s and %s to hold different elements", expected, actual),
+	}, msg)
+	return r
+}
+
+// AssertJSONEqualApprox asserts that the JSON documents expected and actual
+// are equal, except that numbers may differ by at most tolerance. Everything
+// else, including object keys, array lengths, strings, booleans and nulls, is
+// compared exactly; key order and formatting do not matter. On failure, each
+// difference is reported with its path, and numbers with both values.
+//
+// Example:
+//
+//	r.AssertJSONEqualApprox(`{"total": 10.5, "ratio": 0.333}`, rec.Body.String(), 1e-3)
+func (r *R) AssertJSONEqualApprox(expected, actual string, tolerance float64, msg ...string) *R {
+	r.T.Helper()
+	var ev, av any
+	if err := json.Unmarshal([]byte(expected), &ev); err != nil {
+		r.Fail("Invalid expected JSON: %v", err)
+		return r
+	}
+	if err := json.Unmarshal([]byte(actual), &av); err != nil {
+		r.Fail("Invalid actual JSON: %v", err)
+		return r
+	}
+	var diffs []string
+	approxDiff("$", ev, av, tolerance, &diffs)
+	r.report(check{
+		ok:      len(diffs) == 0,
+		pass:    fmt.Sprintf("JSON documents are equal within %g", tolerance),
+		fail:    fmt.Sprintf("Expected JSON to equal %s within %g, but:\n\t%s", expected, tolerance, strings.Join(diffs, "\n\t")),
+		notPass: fmt.Sprintf("JSON documents differ by more than %g", tolerance),
+		notFail: fmt.Sprintf("Expected JSON not to equal %s within %g", expected, tolerance),
+	}, msg)
+	return r
+}
+
+// AssertValidJSON asserts that s is a well-formed JSON document. On failure,
+// the parse error is reported with its line and column in s.
+//
+// Example:
+//
+//	r.AssertValidJSON(rec.Body.String())
+func (r *R) AssertValidJSON(s string, msg ...string) *R {
+	r.T.Helper()
+	var v any
+	err := json.Unmarshal([]byte(s), &v)
+	fail := ""
+	if err != nil {
+		fail = fmt.Sprintf("Expected valid JSON, but %s: %v", jsonErrorPosition(s, err), err)
+	}
+	r.report(check{
+		ok:      err == nil,
+		pass:    "String is valid JSON",
+		fail:    fail,
+		notPass: "String is not valid JSON",
+		notFail: "Expected invalid JSON, but the string is valid",
+	}, msg)
+	return r
+}
+
+// jsonErrorPosition describes where the JSON syntax error err occurred in s.
+func jsonErrorPosition(s string, err error) string {
+	var syntax *json.SyntaxError
+	if !errors.As(err, &syntax) {
+		return "it cannot be decoded"
+	}
+	// Offset counts the bytes read, including the offending one.
+	i := min(max(int(syntax.Offset)-1, 0), len(s))
+	line := strings.Count(s[:i], "\n") + 1
+	col := i - strings.LastIndex(s[:i], "\n")
+	return fmt.Sprintf("parsing failed at line %d, column %d", line, col)
+}
+
+// approxDiff appends a line to diffs for every difference between the decoded
+// JSON values expected and actual at path, allowing numbers to differ by at
+// most tolerance.
+func approxDiff(path string, expected, actual any, tolerance float64, diffs *[]string) {
+	ek, ak := jsonKind(expected), jsonKind(actual)
+	if ek != ak {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expected %s, got %s", path, ek, ak))
+		return
+	}
+	switch e := expected.(type) {
+	case map[string]any:
+		a := actual.(map[string]any)
+		for _, k := range sortedKeys(e) {
+			if _, ok := a[k]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: missing key", path, k))
+				continue
+			}
+			approxDiff(path+"."+k, e[k], a[k], tolerance, diffs)
+		}
+		for _, k := range sortedKeys(a) {
+			if _, ok := e[k]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: unexpected key", path, k))
+			}
+		}
+	case []any:
+		a := actual.([]any)
+		if len(e) != len(a) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expected %d elements, got %d", path, len(e), len(a)))
+			return
+		}
+		for i := range e {
+			approxDiff(fmt.Sprintf("%s[%d]", path, i), e[i], a[i], tolerance, diffs)
+		}
+	case float64:
+		if a := actual.(float64); math.Abs(e-a) > tolerance {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expected %v, got %v (difference %g)", path, e, a, math.Abs(e-a)))
+		}
+	default:
+		if expected != actual {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expected %s, got %s", path, compactJSON(expected), compactJSON(actual)))
+		}
+	}
+}
+
+// compactJSON formats v as compact JSON for failure messages.
+func compactJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// shapeDiff appends a line to diffs for every difference in keys or kinds
+// between the decoded JSON values expected and actual at path.
+func shapeDiff(path string, expected, actual any, diffs *[]string) {
+	ek, ak := jsonKind(expected), jsonKind(actual)
+	if ek != ak {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expected %s, got %s", path, ek, ak))
+		return
+	}
+	switch e := expected.(type) {
+	case map[string]any:
+		a := actual.(map[string]any)
+		for _, k := range sortedKeys(e) {
+			if _, ok := a[k]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: missing key", path, k))
+				continue
+			}
+			shapeDiff(path+"."+k, e[k], a[k], diffs)
+		}
+		for _, k := range sortedKeys(a) {
+			if _, ok := e[k]; !ok {
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: unexpected key", path, k))
+			}
+		}
+	case []any:
+		if len(e) == 0 {
+			return
+		}
+		for i, v := range actual.([]any) {
+			shapeDiff(fmt.Sprintf("%s[%d]", path, i), e[0], v, diffs)
+		}
+	}
+}
+
+// jsonKind returns the JSON kind of a value decoded by encoding/json.
+func jsonKind(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,82 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertJSONShape tests comparing the keys and kinds of JSON documents
+func TestAssertJSONShape(t *testing.T) {
+	r := got.New(t, "Test AssertJSONShape")
+	template := `{"id": 0, "name": "", "active": false, "tags": [""], "owner": {"id": 0}, "deleted": null}`
+
+	r.Case("Testing documents with the same shape")
+	r.AssertJSONShape(template, `{"id": 42, "name": "alice", "active": true, "tags": ["a", "b"], "owner": {"id": 7}, "deleted": null}`)
+	r.AssertJSONShape(template, `{"deleted": null, "owner": {"id": 1}, "tags": [], "active": false, "name": "", "id": 1}`)
+	r.AssertJSONShape(`[]`, `[1, "two", {}]`)
+
+	r.Case("Testing documents with a different shape")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{"id": 0}`, `{"id": "42"}`) }), "different kind should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{"id": 0, "name": ""}`, `{"id": 1}`) }), "missing key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{"id": 0}`, `{"id": 1, "extra": true}`) }), "extra key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{"tags": [""]}`, `{"tags": ["a", 1]}`) }), "mismatched array element should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{"owner": {"id": 0}}`, `{"owner": {}}`) }), "missing nested key should fail")
+
+	r.Case("Testing invalid JSON")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{`, `{}`) }), "invalid template should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONShape(`{}`, `not json`) }), "invalid document should fail")
+}
+
+// TestAssertJSONArrayUnordered tests comparing JSON arrays ignoring element order
+func TestAssertJSONArrayUnordered(t *testing.T) {
+	r := got.New(t, "Test AssertJSONArrayUnordered")
+
+	r.Case("Testing arrays with the same elements")
+	r.AssertJSONArrayUnordered(`[{"id": 1, "name": "a"}, {"id": 2}]`, `[{"id":2},{"name":"a","id":1}]`)
+	r.AssertJSONArrayUnordered(`[1, 1, "x"]`, `["x", 1, 1]`)
+	r.AssertJSONArrayUnordered(`[]`, `[]`)
+
+	r.Case("Testing arrays with different elements")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONArrayUnordered(`[1, 1, 2]`, `[1, 2, 2]`) }), "different multiplicities should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONArrayUnordered(`[{"id": 1}]`, `[{"id": 1}, {"id": 2}]`) }), "extra element should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONArrayUnordered(`[1]`, `{"id": 1}`) }), "non-array should fail")
+}
+
+// TestAssertValidJSON tests checking that strings are well-formed JSON
+func TestAssertValidJSON(t *testing.T) {
+	r := got.New(t, "Test AssertValidJSON")
+
+	r.Case("Testing valid JSON")
+	r.AssertValidJSON(`{"id": 1, "tags": ["a"]}`).AssertValidJSON(`null`).AssertValidJSON(" [1, 2] ")
+
+	r.Case("Testing invalid JSON")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertValidJSON(`{"id": }`) }), "malformed JSON should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertValidJSON(``) }), "empty string should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertValidJSON(`{"a": 1} x`) }), "trailing data should fail")
+	r.Not().AssertValidJSON(`{'a': 1}`)
+
+	r.Case("Testing the error position")
+	_, out := gottest.Detached(func(pr *got.R) { pr.AssertValidJSON("{\n  \"id\": 1,\n  \"name\": }\n") })
+	r.AssertContains(out, "line 3, column 11")
+}
+
+// TestAssertJSONEqualApprox tests comparing JSON with a numeric tolerance
+func TestAssertJSONEqualApprox(t *testing.T) {
+	r := got.New(t, "Test AssertJSONEqualApprox")
+
+	r.Case("Testing documents equal within the tolerance")
+	r.AssertJSONEqualApprox(`{"total": 10.5, "items": [0.1, 0.2], "name": "a"}`,
+		`{"name":"a","items":[0.1000001,0.2],"total":10.4999}`, 1e-3)
+	r.AssertJSONEqualApprox(`[1, null, true]`, `[1, null, true]`, 0)
+	r.Not().AssertJSONEqualApprox(`{"total": 10.5}`, `{"total": 10.6}`, 1e-3)
+
+	r.Case("Testing documents that differ")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`{"total": 10.5}`, `{"total": 10.6}`, 1e-3) }), "number beyond tolerance should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`{"name": "a"}`, `{"name": "b"}`, 1) }), "different string should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`[1, 2]`, `[1]`, 1) }), "different array length should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`{"a": 1}`, `{"a": 1, "b": 2}`, 1) }), "extra key should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`{"a": 1}`, `{"a": "1"}`, 1) }), "different kind should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertJSONEqualApprox(`{`, `{}`, 1) }), "invalid JSON should fail")
+}
@@ -0,0 +1,176 @@
+package got
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+)
+
+// jsonCase is the file representation of a test case.
+type jsonCase struct {
+	Name    string          `json:"name"`
+	Input   json.RawMessage `json:"input"`
+	Want    json.RawMessage `json:"want"`
+	WantErr bool            `json:"wantErr"`
+	Err     string          `json:"err"`
+}
+
+// LoadCasesJSON reads table-driven test cases from a JSON file.
+// The file must contain an array of objects with the fields "name", "input",
+// "want", "wantErr" and "err". The input and want values are decoded into new
+// values of inputType and wantType respectively; a nil type decodes into the
+// default JSON representation (map[string]any, []any, float64, ...). A non-empty
+// "err" is turned into an error with that message.
+//
+// Parameters:
+//   - path: The path of the JSON file
+//   - inputType: The type of the case inputs, or nil
+//   - wantType: The type of the expected results, or nil
+//
+// Returns:
+//   - []Case: The loaded test cases
+//   - error: An error if the file cannot be read or decoded
+//
+// Example:
+//
+//	cases, err := got.LoadCasesJSON("testdata/add.json",
+//		reflect.TypeOf([]int{}), reflect.TypeOf(0))
+//	r.AssertNoErr(err)
+//	r.Cases(cases, func(c got.Case, tt *testing.T) {
+//		in := c.Input().([]int)
+//		r.AssertEqual(c.Want(), add(in[0], in[1]))
+//	})
+func LoadCasesJSON(path string, inputType, wantType reflect.Type) ([]Case, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cases file: %w", err)
+	}
+	var records []jsonCase
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode cases file %s: %w", path, err)
+	}
+	cases := make([]Case, 0, len(records))
+	for i, rec := range records {
+		input, err := decodeJSONValue(rec.Input, inputType)
+		if err != nil {
+			return nil, fmt.Errorf("case %d (%s): invalid input: %w", i, rec.Name, err)
+		}
+		want, err := decodeJSONValue(rec.Want, wantType)
+		if err != nil {
+			return nil, fmt.Errorf("case %d (%s): invalid want: %w", i, rec.Name, err)
+		}
+		cases = append(cases, NewCase(rec.Name, input, want, rec.WantErr, caseErr(rec.Err)))
+	}
+	return cases, nil
+}
+
+// LoadCasesCSV reads table-driven test cases from a CSV file, such as a
+// table of numeric vectors maintained in a spreadsheet. The first record is
+// the header and is skipped; every other record is turned into a case by
+// parse. Quoting follows encoding/csv, and all records must have as many
+// fields as the header; decoding errors carry the line of the record. Errors
+// wrap the underlying error, so errors.Is can match fs.ErrNotExist. Use
+// LoadCasesCSVE if parsing a record can fail.
+//
+// Parameters:
+//   - path: The path of the CSV file
+//   - parse: The function turning the fields of a record into a case
+//
+// Returns:
+//   - []Case: The loaded test cases
+//   - error: An error if the file cannot be read or decoded
+//
+// Example:
+//
+//	cases, err := got.LoadCasesCSV("testdata/greet.csv", func(rec []string) got.Case {
+//		return got.NewCase(rec[0], rec[1], rec[2], false, nil)
+//	})
+func LoadCasesCSV(path string, parse func(record []string) Case) ([]Case, error) {
+	return LoadCasesCSVE(path, func(record []string) (Case, error) {
+		return parse(record), nil
+	})
+}
+
+// LoadCasesCSVE is like LoadCasesCSV, but parse returns an error, rather than
+// only a case, so that a malformed record is reported with the line of the
+// record in the file instead of being turned into a bogus case or a panic.
+// Errors wrap the errors returned by parse.
+//
+// Parameters:
+//   - path: The path of the CSV file
+//   - parse: The function turning the fields of a record into a case
+//
+// Returns:
+//   - []Case: The loaded test cases
+//   - error: An error if the file cannot be read or a record is invalid
+//
+// Example:
+//
+//	cases, err := got.LoadCasesCSVE("testdata/add.csv", func(rec []string) (got.Case, error) {
+//		a, errA := strconv.Atoi(rec[1])
+//		b, errB := strconv.Atoi(rec[2])
+//		want, errW := strconv.Atoi(rec[3])
+//		return got.NewCase(rec[0], []int{a, b}, want, false, nil), errors.Join(errA, errB, errW)
+//	})
+func LoadCasesCSVE(path string, parse func(record []string) (Case, error)) ([]Case, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cases file: %w", err)
+	}
+	defer f.Close()
+	cr := csv.NewReader(f)
+	if _, err := cr.Read(); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("cases file %s has no header", path)
+		}
+		return nil, fmt.Errorf("failed to decode cases file %s: %w", path, err)
+	}
+	var cases []Case
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return cases, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode cases file %s: %w", path, err)
+		}
+		c, err := parse(record)
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("%s:%d: invalid case: %w", path, line, err)
+		}
+		cases = append(cases, c)
+	}
+}
+
+// decodeJSONValue decodes raw into a new value of type typ, or into an any if
+// typ is nil. A missing value yields the zero value of typ.
+func decodeJSONValue(raw json.RawMessage, typ reflect.Type) (any, error) {
+	if typ == nil {
+		var v any
+		if len(raw) == 0 {
+			return nil, nil
+		}
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	}
+	ptr := reflect.New(typ)
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return ptr.Elem().Interface(), nil
+}
+
+// caseErr converts an error message from a cases file into an error.
+func caseErr(msg string) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.New(msg)
+}
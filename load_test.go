@@ -0,0 +1,101 @@
+package got_test
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// TestLoadCasesJSON tests loading cases from a JSON file
+func TestLoadCasesJSON(t *testing.T) {
+	r := got.New(t, "Test LoadCasesJSON")
+
+	r.Case("Testing typed cases")
+	path := r.TempFile("cases-*.json", []byte(`[
+		{"name": "add", "input": [1, 2], "want": 3},
+		{"name": "overflow", "input": [1], "wantErr": true, "err": "need two operands"}
+	]`))
+	cases, err := got.LoadCasesJSON(path, reflect.TypeOf([]int{}), reflect.TypeOf(0))
+	r.AssertNoErr(err)
+	r.AssertEqual(2, len(cases))
+	r.AssertEqual("add", cases[0].Name())
+	r.AssertEqual([]int{1, 2}, cases[0].Input())
+	r.AssertEqual(3, cases[0].Want())
+	r.AssertFalse(cases[0].WantErr())
+	r.AssertNil(cases[0].Err())
+	r.AssertEqual(0, cases[1].Want(), "missing want should decode to the zero value")
+	r.AssertTrue(cases[1].WantErr())
+	r.AssertEqual("need two operands", cases[1].Err().Error())
+
+	r.Case("Testing untyped cases")
+	cases, err = got.LoadCasesJSON(path, nil, nil)
+	r.AssertNoErr(err)
+	r.AssertEqual([]any{1.0, 2.0}, cases[0].Input())
+	r.AssertNil(cases[1].Want())
+
+	r.Case("Testing invalid files")
+	_, err = got.LoadCasesJSON(path+".missing", nil, nil)
+	r.AssertTrue(errors.Is(err, fs.ErrNotExist), "a missing file should wrap fs.ErrNotExist")
+	_, err = got.LoadCasesJSON(r.TempFile("bad-*.json", []byte(`{`)), nil, nil)
+	r.AssertErr(err)
+	_, err = got.LoadCasesJSON(path, reflect.TypeOf(""), nil)
+	r.AssertErr(err)
+}
+
+// TestLoadCasesCSV tests loading cases from a CSV file
+func TestLoadCasesCSV(t *testing.T) {
+	r := got.New(t, "Test LoadCasesCSV")
+	parse := func(rec []string) got.Case {
+		return got.NewCase(rec[0], rec[1:3], rec[3], false, nil)
+	}
+
+	r.Case("Testing valid files")
+	path := r.TempFile("cases-*.csv", []byte("name,a,b,want\nadd,1,2,3\n\"quoted, name\",-1,1,\"\"\"zero\"\"\"\n"))
+	cases, err := got.LoadCasesCSV(path, parse)
+	r.AssertNoErr(err)
+	r.AssertEqual(2, len(cases))
+	r.AssertEqual("add", cases[0].Name())
+	r.AssertEqual([]string{"1", "2"}, cases[0].Input())
+	r.AssertEqual("3", cases[0].Want())
+	r.AssertEqual("quoted, name", cases[1].Name())
+	r.AssertEqual(`"zero"`, cases[1].Want())
+	cases, err = got.LoadCasesCSV(r.TempFile("header-*.csv", []byte("name,a,b,want\n")), parse)
+	r.AssertNoErr(err)
+	r.AssertEqual(0, len(cases))
+
+	r.Case("Testing invalid files")
+	_, err = got.LoadCasesCSV(path+".missing", parse)
+	r.AssertTrue(errors.Is(err, fs.ErrNotExist), "a missing file should wrap fs.ErrNotExist")
+	_, err = got.LoadCasesCSV(r.TempFile("empty-*.csv", nil), parse)
+	r.AssertErr(err)
+	_, err = got.LoadCasesCSV(r.TempFile("fields-*.csv", []byte("name,a,b,want\nadd,1,2,3\nadd,1,2\n")), parse)
+	r.AssertErr(err)
+	r.AssertContains(err.Error(), "line 3")
+}
+
+// TestLoadCasesCSVE tests loading cases from a CSV file with a failing parser
+func TestLoadCasesCSVE(t *testing.T) {
+	r := got.New(t, "Test LoadCasesCSVE")
+	parse := func(rec []string) (got.Case, error) {
+		a, errA := strconv.Atoi(rec[1])
+		b, errB := strconv.Atoi(rec[2])
+		return got.NewCase(rec[0], []int{a, b}, rec[3], false, nil), errors.Join(errA, errB)
+	}
+
+	r.Case("Testing valid files")
+	cases, err := got.LoadCasesCSVE(r.TempFile("cases-*.csv", []byte("name,a,b,want\nadd,1,2,3\n")), parse)
+	r.AssertNoErr(err)
+	r.AssertEqual(1, len(cases))
+	r.AssertEqual([]int{1, 2}, cases[0].Input())
+
+	r.Case("Testing invalid records")
+	bad := r.TempFile("bad-*.csv", []byte("name,a,b,want\nadd,1,2,3\nsub,x,1,0\n"))
+	_, err = got.LoadCasesCSVE(bad, parse)
+	r.AssertErr(err)
+	r.AssertContains(err.Error(), bad+":3: invalid case")
+	r.AssertTrue(errors.Is(err, strconv.ErrSyntax), "the error of parse should be wrapped")
+}
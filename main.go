@@ -0,0 +1,55 @@
+package got
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+)
+
+// Main runs the tests of a package between a one-time setup and teardown.
+// Call it from TestMain: it runs setup, then m.Run, then teardown, and exits
+// with the exit code of m.Run. If setup fails, the error is printed, no test
+// is run and the process exits with code 1; teardown still runs, so it must
+// cope with a partial setup. Teardown also runs if m.Run panics on the main
+// goroutine, and if a subtest started through a runner, such as by Run, Caser
+// or Cases, panics: the panic is recovered to run teardown, then raised again.
+// That teardown runs as soon as the subtest panics, while its parallel
+// siblings may still be running against the resources it releases, and fail
+// spuriously until the process exits. Teardown runs at most once. A panic in
+// the body of a top-level test function still kills the process before
+// teardown can run; use t.Cleanup for resources that must be released then.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//		got.Main(m, func() error {
+//			return startDatabase()
+//		}, stopDatabase)
+//	}
+func Main(m *testing.M, setup func() error, teardown func()) {
+	os.Exit(runMain(m, setup, teardown, os.Stderr))
+}
+
+// mainTeardown is the teardown of the running Main, run by the subtests that
+// panic. It does nothing outside Main.
+var mainTeardown = sync.OnceFunc(func() {})
+
+// runMain implements Main and returns the exit code.
+func runMain(m interface{ Run() int }, setup func() error, teardown func(), stderr io.Writer) int {
+	if teardown == nil {
+		teardown = func() {}
+	}
+	teardown = sync.OnceFunc(teardown)
+	mainTeardown = teardown
+	defer teardown()
+	defer func() { mainTeardown = sync.OnceFunc(func() {}) }()
+	if setup != nil {
+		if err := setup(); err != nil {
+			fmt.Fprintf(stderr, "setup failed: %v\n", err)
+			return 1
+		}
+	}
+	return m.Run()
+}
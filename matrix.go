@@ -0,0 +1,89 @@
+package got
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// maxMatrixCombinations caps the number of subtests Matrix may create.
+const maxMatrixCombinations = 10000
+
+// Matrix runs f once for every combination of the values in dims, as a
+// subtest named after the combination, such as "db=mysql,version=8". The
+// dimensions are ordered by name and the last one varies fastest. The number
+// of combinations is logged; more than 10000 combinations stop the test, as
+// they usually indicate a mistake. Without dimensions, or if a dimension has
+// no values, there are no combinations and f is never called.
+//
+// Parameters:
+//   - dims: The values of each dimension, keyed by dimension name
+//   - f: The test function, called with one value per dimension
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Matrix(map[string][]any{
+//		"db":      {"mysql", "postgres"},
+//		"version": {8, 9},
+//	}, func(combo map[string]any, tt *testing.T) {
+//		r.AssertNoErr(connect(combo["db"].(string), combo["version"].(int)))
+//	})
+func (r *R) Matrix(dims map[string][]any, f func(combo map[string]any, tt *testing.T)) *R {
+	r.T.Helper()
+	if len(dims) == 0 {
+		r.Logf("Matrix of 0 combinations: no dimensions")
+		return r
+	}
+	names := make([]string, 0, len(dims))
+	for name := range dims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if len(dims[name]) == 0 {
+			r.Logf("Matrix of 0 combinations over %s: dimension %s is empty", strings.Join(names, ", "), name)
+			return r
+		}
+	}
+	total := 1
+	for _, name := range names {
+		total *= len(dims[name])
+		if total > maxMatrixCombinations {
+			r.Fatal("Matrix has more than %d combinations", maxMatrixCombinations)
+			return r
+		}
+	}
+	r.Logf("Matrix of %d combinations over %s", total, strings.Join(names, ", "))
+
+	index := make([]int, len(names))
+	for {
+		combo := make(map[string]any, len(names))
+		parts := make([]string, len(names))
+		for i, name := range names {
+			v := dims[name][index[i]]
+			combo[name] = v
+			parts[i] = fmt.Sprintf("%s=%v", name, v)
+		}
+		label := strings.Join(parts, ",")
+		r.Case(label)
+		r.Run(label, func(tt *testing.T) {
+			f(combo, tt)
+		})
+
+		i := len(index) - 1
+		for ; i >= 0; i-- {
+			index[i]++
+			if index[i] < len(dims[names[i]]) {
+				break
+			}
+			index[i] = 0
+		}
+		if i < 0 {
+			return r
+		}
+	}
+}
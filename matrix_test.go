@@ -0,0 +1,47 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestMatrix tests running every combination of the dimensions
+func TestMatrix(t *testing.T) {
+	r := got.New(t, "Test Matrix")
+
+	r.Case("Testing all combinations are run in order")
+	var names []string
+	var combos []map[string]any
+	r.Matrix(map[string][]any{
+		"version": {8, 9},
+		"db":      {"mysql", "postgres"},
+	}, func(combo map[string]any, tt *testing.T) {
+		names = append(names, tt.Name())
+		combos = append(combos, combo)
+	})
+	r.AssertEqual([]string{
+		"TestMatrix/db=mysql,version=8",
+		"TestMatrix/db=mysql,version=9",
+		"TestMatrix/db=postgres,version=8",
+		"TestMatrix/db=postgres,version=9",
+	}, names)
+	r.AssertEqual(map[string]any{"db": "postgres", "version": 8}, combos[2])
+
+	r.Case("Testing empty dimensions")
+	calls := 0
+	r.Matrix(map[string][]any{"db": {"mysql"}, "version": {}}, func(map[string]any, *testing.T) { calls++ })
+	r.Matrix(nil, func(map[string]any, *testing.T) { calls++ })
+	huge := make([]any, 20000)
+	r.AssertFalse(gottest.Probe(func(pr *got.R) {
+		pr.Matrix(map[string][]any{"a": {}, "b": huge, "c": huge}, func(map[string]any, *testing.T) { calls++ })
+	}), "an empty dimension should not trip the combination guard")
+	r.AssertEqual(0, calls, "no combinations should run")
+
+	r.Case("Testing the combination guard")
+	big := make([]any, 200)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Matrix(map[string][]any{"a": big, "b": big}, func(map[string]any, *testing.T) {})
+	}), "too many combinations should fail")
+}
@@ -0,0 +1,53 @@
+package got
+
+// Must takes the results of a call returning a value and an error, and
+// returns a function that gives back the value when called with the runner,
+// or fails and stops the test if the error is not nil. It shortens setup code
+// such as x, err := f(); r.AssertNoErr(err).
+//
+// Go does not allow passing a multi-value call together with other arguments,
+// so the runner is supplied in a second call rather than as a first argument.
+//
+// Parameters:
+//   - v: The value to return
+//   - err: The error to check
+//
+// Returns:
+//   - func(r *R) T: A function returning v, failing the test via r on error
+//
+// Example:
+//
+//	cfg := got.Must(LoadConfig("testdata/config.yaml"))(r)
+func Must[T any](v T, err error) func(r *R) T {
+	return func(r *R) T {
+		if err != nil {
+			r.Helper()
+			r.Fatal("Unexpected error: %v", err)
+		}
+		return v
+	}
+}
+
+// NoPanic runs fn and returns its result, failing and stopping the test if fn
+// panics. The recovered value is reported. It is the counterpart of the panic
+// assertions for setup code that must return normally.
+//
+// Parameters:
+//   - r: The runner used to report a panic
+//   - fn: The function to run
+//
+// Returns:
+//   - T: The value returned by fn
+//
+// Example:
+//
+//	cfg := got.NoPanic(r, func() *Config { return MustLoadConfig("testdata/config.yaml") })
+func NoPanic[T any](r *R, fn func() T) T {
+	var v T
+	recovered, panicked := catchPanic(func() { v = fn() })
+	if panicked {
+		r.Helper()
+		r.Fatal("Unexpected panic: %v", recovered)
+	}
+	return v
+}
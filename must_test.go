@@ -0,0 +1,45 @@
+package got_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestMust tests converting (T, error) results
+func TestMust(t *testing.T) {
+	r := got.New(t, "Test Must")
+
+	r.Case("Testing successful calls")
+	n := got.Must(strconv.Atoi("42"))(r)
+	r.AssertEqual(42, n)
+
+	r.Case("Testing failed calls")
+	reached := false
+	failed := gottest.Probe(func(pr *got.R) {
+		got.Must(0, errors.New("boom"))(pr)
+		reached = true
+	})
+	r.AssertTrue(failed, "Must should fail on error")
+	r.AssertFalse(reached, "Must should stop the test on error")
+}
+
+// TestNoPanic tests capturing the result of functions that must not panic
+func TestNoPanic(t *testing.T) {
+	r := got.New(t, "Test NoPanic")
+
+	r.Case("Testing functions returning normally")
+	r.AssertEqual("ok", got.NoPanic(r, func() string { return "ok" }))
+
+	r.Case("Testing panicking functions")
+	reached := false
+	failed := gottest.Probe(func(pr *got.R) {
+		got.NoPanic(pr, func() int { panic("boom") })
+		reached = true
+	})
+	r.AssertTrue(failed, "NoPanic should fail on panic")
+	r.AssertFalse(reached, "NoPanic should stop the test on panic")
+}
@@ -0,0 +1,64 @@
+package got
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Only restricts the rows run by the following Cases and CasesMap calls to
+// those whose name matches the regular expression pattern. It is meant for
+// debugging a single row of a large table without editing the table; remove
+// it once done. The pattern is matched against both the case name and its
+// subtest name, in which spaces are replaced with underscores. An empty
+// pattern removes the filter. An invalid pattern stops the test.
+//
+// Rows can also be selected without code changes with the -run flag, since
+// each row is a subtest named after the case, with spaces replaced with
+// underscores:
+//
+//	go test -run 'TestUser/find_existing'
+//
+// Parameters:
+//   - pattern: The regular expression selecting the rows to run
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Only("^find existing$").Cases(cases, func(c got.Case, tt *testing.T) {
+//		// only the "find existing" row runs
+//	})
+func (r *R) Only(pattern string) *R {
+	r.T.Helper()
+	root := r.root()
+	if pattern == "" {
+		root.only = nil
+		return r
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		r.Fatalf("Invalid Only pattern %q: %v", pattern, err)
+		return r
+	}
+	root.only = re
+	return r
+}
+
+// selectCases returns the cases allowed by the Only filter, logging how many
+// were skipped.
+func (r *R) selectCases(cases []Case) []Case {
+	r.T.Helper()
+	re := r.root().only
+	if re == nil {
+		return cases
+	}
+	selected := make([]Case, 0, len(cases))
+	for _, c := range cases {
+		if re.MatchString(c.Name()) || re.MatchString(strings.ReplaceAll(c.Name(), " ", "_")) {
+			selected = append(selected, c)
+		}
+	}
+	r.Logf("Only %q: running %d of %d cases", re, len(selected), len(cases))
+	return selected
+}
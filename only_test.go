@@ -0,0 +1,42 @@
+package got_test
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestOnly tests filtering the rows run by Cases
+func TestOnly(t *testing.T) {
+	r := got.New(t, "Test Only")
+	cases := []got.Case{
+		got.NewCase("find existing", 1, "alice", false, nil),
+		got.NewCase("find missing", 2, nil, true, nil),
+		got.NewCase("delete", 1, nil, false, nil),
+	}
+	run := func() []string {
+		var names []string
+		r.Cases(cases, func(c got.Case, tt *testing.T) { names = append(names, c.Name()) })
+		return names
+	}
+
+	r.Case("Testing filters")
+	r.Only("^find")
+	r.AssertEqual([]string{"find existing", "find missing"}, run())
+	r.Only("^find_missing$")
+	r.AssertEqual([]string{"find missing"}, run(), "subtest names should match")
+	r.Only("^remove$")
+	var names []string
+	r.CasesMap(map[string]got.Case{"remove": cases[2], "keep": cases[0]}, func(c got.Case, tt *testing.T) {
+		names = append(names, c.Name())
+	})
+	r.AssertEqual([]string{"remove"}, names, "CasesMap should honor the filter")
+
+	r.Case("Testing removing the filter")
+	r.Only("")
+	r.AssertEqual(3, len(run()))
+
+	r.Case("Testing an invalid pattern")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Only("(") }), "invalid pattern should fail")
+}
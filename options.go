@@ -0,0 +1,107 @@
+package got
+
+import (
+	"io"
+	"sync"
+)
+
+// Option configures a runner created by New.
+type Option func(*config)
+
+// config holds the settings resolved from the options passed to New.
+type config struct {
+	color    *bool         // nil detects color support from the environment
+	buffered bool          // whether output is buffered (see SetBuffered)
+	quiet    bool          // whether output is only written if the test fails
+	reporter *lockedWriter // extra destination for the runner's output
+	stop     bool          // whether failed assertions stop the test (see StopOnFirstFailure)
+}
+
+// lockedWriter serializes writes to a reporter shared by parallel tests.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WithColor forces colored pass/fail marks on or off instead of detecting
+// color support from the NO_COLOR and TERM environment variables.
+func WithColor(on bool) Option {
+	return func(c *config) {
+		c.color = &on
+	}
+}
+
+// WithBuffered buffers the runner's output from the start, as if
+// SetBuffered(true) had been called.
+func WithBuffered() Option {
+	return func(c *config) {
+		c.buffered = true
+	}
+}
+
+// WithFailureContext buffers the runner's output and writes it only if the
+// test fails, so that passing tests stay silent while a failure is shown with
+// the cases and passing assertions that led to it. It implies WithBuffered.
+func WithFailureContext() Option {
+	return func(c *config) {
+		c.buffered = true
+		c.quiet = true
+	}
+}
+
+// WithReporter writes every line logged by the runner to w, one line per
+// write, in addition to the test log. Use it to collect the output of a
+// suite in a file or to inspect it in tests. Writes are serialized, so w
+// can be shared by parallel tests.
+func WithReporter(w io.Writer) Option {
+	return func(c *config) {
+		c.reporter = &lockedWriter{w: w}
+	}
+}
+
+// WithStopOnFirstFailure makes the runner stop the test at its first failed
+// assertion, as if StopOnFirstFailure(true) had been called.
+func WithStopOnFirstFailure() Option {
+	return func(c *config) {
+		c.stop = true
+	}
+}
+
+// StopOnFirstFailure sets whether a failed assertion stops the test. While it
+// is on, every call to Fail, and so every failing Assert*, Require and
+// Expect matcher alike, is followed by t.FailNow, which avoids a cascade of
+// failures caused by the first one. Inside a subtest started through the
+// runner, the subtest is stopped, even when the assertion is made through the
+// parent runner. Like t.FailNow, the failing assertion must then run on the
+// goroutine of that test. It is meant for debugging; a known issue marked
+// with ExpectFailure does not stop the test.
+//
+// Example:
+//
+//	r.StopOnFirstFailure(true)
+//	r.AssertEqual(1, len(users)) // stops here on failure
+//	r.AssertEqual("alice", users[0].Name)
+func (r *R) StopOnFirstFailure(on bool) *R {
+	r.root().cfg.stop = on
+	return r
+}
+
+// color reports whether the runner prints colored marks.
+func (r *R) color() bool {
+	if r.cfg.color != nil {
+		return *r.cfg.color
+	}
+	return checkColorSupport()
+}
+
+// forward writes a logged line to the runner's reporter, if any.
+func (r *R) forward(line string) {
+	r.T.Helper()
+	rep := r.cfg.reporter
+	if rep == nil {
+		return
+	}
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	io.WriteString(rep.w, line+"\n")
+}
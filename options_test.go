@@ -0,0 +1,109 @@
+package got
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestNewOptions tests configuring a runner with functional options
+func TestNewOptions(t *testing.T) {
+	r := New(t, "Test NewOptions")
+	if r.buf != nil || r.cfg.color != nil || r.cfg.reporter != nil {
+		t.Error("expected a runner without options to use the defaults")
+	}
+
+	r = New(t, "buffered", WithBuffered())
+	if r.buf == nil {
+		t.Fatal("expected WithBuffered to enable buffering")
+	}
+	if n := len(r.buf.lines); n != 1 {
+		t.Errorf("expected the title line to be buffered, got %d lines", n)
+	}
+
+	var out bytes.Buffer
+	r = New(t, "plain", WithColor(false), WithReporter(&out))
+	r.Case("reported")
+	r.Pass("value %d", 1)
+	want := "Test Case => plain\nCase 1 -> reported\n\t[PASS] value 1\n"
+	if out.String() != want {
+		t.Errorf("expected reporter output %q, got %q", want, out.String())
+	}
+
+	out.Reset()
+	r = New(t, "colored", WithColor(true), WithReporter(&out))
+	r.Pass("value")
+	if !strings.Contains(out.String(), checkMark) {
+		t.Errorf("expected WithColor(true) to print the colored mark, got %q", out.String())
+	}
+}
+
+// TestStopOnFirstFailure tests stopping the test at the first failed assertion
+func TestStopOnFirstFailure(t *testing.T) {
+	r := New(t, "Test StopOnFirstFailure")
+
+	// runStopped runs fn on a detached test and reports the lines logged and
+	// whether fn returned.
+	runStopped := func(fn func(pr *R), opts ...Option) (string, bool) {
+		var buf bytes.Buffer
+		returned := false
+		detached(func(pr *R) {
+			fn(pr)
+			returned = true
+		}, append([]Option{WithReporter(&buf), WithColor(false)}, opts...)...)
+		return buf.String(), returned
+	}
+
+	r.Case("Testing the method")
+	out, returned := runStopped(func(pr *R) {
+		pr.StopOnFirstFailure(true)
+		pr.AssertEqual(1, 1).AssertEqual(1, 2).AssertEqual(3, 4)
+	})
+	r.AssertFalse(returned, "the test should stop at the first failure")
+	r.AssertEqual(1, strings.Count(out, "[FAIL]"), out)
+
+	r.Case("Testing the option and negated assertions")
+	_, returned = runStopped(func(pr *R) {
+		pr.Require(false, "not stopping")
+	})
+	r.AssertTrue(returned, "without the option the test should continue")
+	_, returned = runStopped(func(pr *R) {
+		pr.Not().AssertEqual(1, 1)
+	}, WithStopOnFirstFailure())
+	r.AssertFalse(returned, "the option should stop negated assertions too")
+
+	r.Case("Testing disabling and known issues")
+	_, returned = runStopped(func(pr *R) {
+		pr.StopOnFirstFailure(true)
+		pr.ExpectFailure("known").AssertEqual(1, 2)
+		pr.StopOnFirstFailure(false)
+		pr.AssertEqual(1, 2)
+	})
+	r.AssertTrue(returned, "known issues and disabled mode should not stop the test")
+}
+
+// TestStopOnFirstFailureSubtest tests stopping a subtest that fails through
+// the parent runner. The failure fails the test, so it runs in a child test
+// process.
+func TestStopOnFirstFailureSubtest(t *testing.T) {
+	if os.Getenv("GOT_STOP_SUBTEST") != "" {
+		r := New(t, "stop", WithStopOnFirstFailure())
+		r.Run("sub", func(tt *testing.T) {
+			r.AssertEqual(1, 2)
+			fmt.Println("subtest continued")
+		})
+		fmt.Println("parent continued")
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestStopOnFirstFailureSubtest$", "-test.v")
+	cmd.Env = append(os.Environ(), "GOT_STOP_SUBTEST=1")
+	out, _ := cmd.CombinedOutput()
+	r := New(t, "Test StopOnFirstFailure in a subtest")
+	r.AssertContains(string(out), "--- FAIL: TestStopOnFirstFailureSubtest/sub")
+	r.AssertContains(string(out), "parent continued", "the parent test should go on after the subtest")
+	r.AssertFalse(strings.Contains(string(out), "subtest continued"), "the subtest should stop at the failure")
+	r.AssertFalse(strings.Contains(string(out), "FailNow on a parent test"), string(out))
+}
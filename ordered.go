@@ -0,0 +1,101 @@
+package got
+
+import "sync"
+
+// CasesOption configures how Cases and CasesMap run their rows.
+type CasesOption func(*casesConfig)
+
+// casesConfig holds the settings resolved from the options passed to Cases.
+type casesConfig struct {
+	ordered bool // whether row output is written in slice order
+}
+
+// Ordered makes Cases hold the output of each row, including its case line,
+// and write it in slice order: the output of a row is written once it and
+// every row before it have completed. This keeps the report readable when
+// rows call t.Parallel and run concurrently. Each row gets its own runner,
+// returned by Sub, that holds its output. Lines logged through the runner
+// Cases was called on are held too while a row runs synchronously, but once
+// the row calls t.Parallel they are written immediately, as without the
+// option, so parallel rows must assert through r.Sub(tt). The held lines are
+// written through the runner Cases was called on once their turn comes, so go
+// test reports them at the place the row is run rather than at the lines that
+// logged them.
+//
+// The output of a row is held in memory until all the rows before it have
+// completed, so with huge tables of parallel rows that log a lot, most of the
+// report may be held in memory until the slowest early rows finish.
+//
+// Example:
+//
+//	r.Cases(cases, func(c got.Case, tt *testing.T) {
+//		tt.Parallel()
+//		sr := r.Sub(tt) // not r: r does not hold the lines of parallel rows
+//		sr.AssertEqual(c.Want(), process(c.Input()))
+//	}, got.Ordered())
+func Ordered() CasesOption {
+	return func(c *casesConfig) {
+		c.ordered = true
+	}
+}
+
+// rowOutput holds the output of the rows of an ordered Cases call.
+type rowOutput struct {
+	mu   sync.Mutex
+	rows []*logBuffer
+	done []bool
+	next int // index of the first row whose output is not written yet
+}
+
+// newRowOutput creates the buffers for the n rows of an ordered Cases call.
+func newRowOutput(n int) *rowOutput {
+	out := &rowOutput{rows: make([]*logBuffer, n), done: make([]bool, n)}
+	for i := range out.rows {
+		out.rows[i] = &logBuffer{}
+	}
+	return out
+}
+
+// complete marks row i of out as completed and writes the output of the
+// leading completed rows through r.
+func (r *R) complete(out *rowOutput, i int) {
+	r.T.Helper()
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	out.done[i] = true
+	// Write while holding the lock, so that rows completing concurrently
+	// cannot reorder their output.
+	for out.next < len(out.rows) && out.done[out.next] {
+		row := out.rows[out.next]
+		row.mu.Lock()
+		for _, line := range row.lines {
+			r.forward(line)
+			if !r.buffer(line) {
+				r.T.Log(line)
+			}
+		}
+		row.lines = nil
+		row.mu.Unlock()
+		out.next++
+	}
+}
+
+// hold appends line to the output of the ordered row that r belongs to, if
+// any, and reports whether it did. While a row runs synchronously, lines
+// logged through the runner Cases was called on belong to the row too.
+func (r *R) hold(line string) bool {
+	r.T.Helper()
+	var row *logBuffer
+	for s := r.root(); s != nil; s = s.active {
+		if s.row != nil {
+			row = s.row
+		}
+	}
+	if row == nil {
+		return false
+	}
+	row.mu.Lock()
+	row.lines = append(row.lines, line)
+	row.mu.Unlock()
+	return true
+}
@@ -0,0 +1,70 @@
+package got_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+)
+
+// TestCasesOrdered tests writing the output of parallel rows in slice order
+func TestCasesOrdered(t *testing.T) {
+	var buf bytes.Buffer
+	cases := []got.Case{
+		got.NewCase("slow", 30*time.Millisecond, nil, false, nil),
+		got.NewCase("medium", 15*time.Millisecond, nil, false, nil),
+		got.NewCase("fast", time.Duration(0), nil, false, nil),
+	}
+	t.Run("rows", func(t *testing.T) {
+		r := got.New(t, "Test Cases Ordered", got.WithReporter(&buf), got.WithColor(false))
+		r.Cases(cases, func(c got.Case, tt *testing.T) {
+			tt.Parallel()
+			sr := r.Sub(tt)
+			sr.Logf("%s: started", c.Name())
+			time.Sleep(c.Input().(time.Duration))
+			sr.Pass("%s: done", c.Name())
+		}, got.Ordered())
+	})
+
+	var want []string
+	for i, c := range cases {
+		want = append(want,
+			fmt.Sprintf("Case %d -> %s", i+1, c.Name()),
+			c.Name()+": started",
+			"\t[PASS] "+c.Name()+": done")
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")[1:]
+	if got := strings.Join(lines, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("Expected rows in slice order:\n%s\ngot:\n%s", strings.Join(want, "\n"), got)
+	}
+}
+
+// TestCasesOrderedParentRunner tests holding the lines that rows log through
+// the runner Cases was called on
+func TestCasesOrderedParentRunner(t *testing.T) {
+	var buf bytes.Buffer
+	cases := []got.Case{
+		got.NewCase("one", 1, 1, false, nil),
+		got.NewCase("two", 2, 2, false, nil),
+	}
+	t.Run("rows", func(t *testing.T) {
+		r := got.New(t, "Test Cases Ordered", got.WithReporter(&buf), got.WithColor(false))
+		r.Cases(cases, func(c got.Case, tt *testing.T) {
+			r.AssertEqual(c.Want(), c.Input())
+		}, got.Ordered())
+	})
+
+	var want []string
+	for i, c := range cases {
+		want = append(want,
+			fmt.Sprintf("Case %d -> %s", i+1, c.Name()),
+			"\t[PASS] Values are equal")
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")[1:]
+	if got := strings.Join(lines, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("Expected each row's lines after its case line:\n%s\ngot:\n%s", strings.Join(want, "\n"), got)
+	}
+}
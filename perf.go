@@ -0,0 +1,250 @@
+package got
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// leakWait is how long AssertNoGoroutineLeak waits for goroutines to exit.
+const leakWait = time.Second
+
+// AssertNoGoroutineLeak runs fn and asserts that it does not leave goroutines
+// running. The goroutine count is recorded before fn, and after fn returns the
+// assertion waits briefly for goroutines to tear down. It fails if the count
+// is still higher than before plus tolerance, printing the stacks of the
+// goroutines that were started meanwhile. The optional tolerance absorbs
+// fluctuations caused by the runtime or other tests running in parallel.
+//
+// Example:
+//
+//	r.AssertNoGoroutineLeak(func() {
+//		pool := NewPool(4)
+//		pool.Close()
+//	})
+func (r *R) AssertNoGoroutineLeak(fn func(), tolerance ...int) *R {
+	r.T.Helper()
+	allowed := 0
+	if len(tolerance) > 0 {
+		allowed = tolerance[0]
+	}
+	before := runtime.NumGoroutine()
+	known := goroutineIDs(goroutineStacks())
+
+	fn()
+
+	after := runtime.NumGoroutine()
+	for deadline := time.Now().Add(leakWait); after > before+allowed && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+		after = runtime.NumGoroutine()
+	}
+	leaked := after > before+allowed
+	if r.report(check{
+		ok:      !leaked,
+		pass:    fmt.Sprintf("No goroutine leaked (%d before, %d after)", before, after),
+		fail:    fmt.Sprintf("Expected no goroutine leak, but goroutines grew from %d to %d (tolerance %d)", before, after, allowed),
+		notPass: fmt.Sprintf("Goroutines grew from %d to %d (tolerance %d)", before, after, allowed),
+		notFail: fmt.Sprintf("Expected a goroutine leak, but goroutines went from %d to %d (tolerance %d)", before, after, allowed),
+	}, nil) || !leaked {
+		return r
+	}
+	for _, stack := range goroutineStacks() {
+		if !known[goroutineID(stack)] {
+			r.Logf("leaked %s", stack)
+		}
+	}
+	return r
+}
+
+// goroutineStacks returns the stack traces of all goroutines, one per entry.
+func goroutineStacks() []string {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	var stacks []string
+	for _, s := range bytes.Split(buf, []byte("\n\n")) {
+		if len(s) > 0 {
+			stacks = append(stacks, string(s))
+		}
+	}
+	return stacks
+}
+
+// goroutineIDs returns the set of goroutine IDs of the given stacks.
+func goroutineIDs(stacks []string) map[string]bool {
+	ids := make(map[string]bool, len(stacks))
+	for _, s := range stacks {
+		ids[goroutineID(s)] = true
+	}
+	return ids
+}
+
+// goroutineID extracts the "goroutine N" header of a stack trace.
+func goroutineID(stack string) string {
+	header, _, _ := strings.Cut(stack, " [")
+	return header
+}
+
+// allocRuns is the number of runs used to average allocations.
+const allocRuns = 100
+
+// AssertMaxAllocs asserts that fn allocates at most maxAllocs times per call,
+// on average over several runs measured with testing.AllocsPerRun. Use it to
+// guard hot paths against allocation regressions.
+//
+// Example:
+//
+//	r.AssertMaxAllocs(func() { _ = buf.String() }, 1)
+func (r *R) AssertMaxAllocs(fn func(), maxAllocs uint64, msg ...string) *R {
+	r.T.Helper()
+	allocs := testing.AllocsPerRun(allocRuns, fn)
+	r.report(check{
+		ok:      allocs <= float64(maxAllocs),
+		pass:    fmt.Sprintf("Function allocated %v times, within %d", allocs, maxAllocs),
+		fail:    fmt.Sprintf("Expected at most %d allocations, got %v", maxAllocs, allocs),
+		notPass: fmt.Sprintf("Function allocated %v times, more than %d", allocs, maxAllocs),
+		notFail: fmt.Sprintf("Expected more than %d allocations, got %v", maxAllocs, allocs),
+	}, msg)
+	return r
+}
+
+// AssertDurationWithin runs fn and asserts that it completes within max.
+// The measured duration is included in the output.
+//
+// Example:
+//
+//	r.AssertDurationWithin(func() { cache.Get("key") }, 10*time.Millisecond)
+func (r *R) AssertDurationWithin(fn func(), max time.Duration, msg ...string) *R {
+	r.T.Helper()
+	elapsed := timeCall(fn)
+	r.report(check{
+		ok:      elapsed <= max,
+		pass:    fmt.Sprintf("Function took %v, within %v", elapsed, max),
+		fail:    fmt.Sprintf("Expected function to take at most %v, took %v", max, elapsed),
+		notPass: fmt.Sprintf("Function took %v, longer than %v", elapsed, max),
+		notFail: fmt.Sprintf("Expected function to take longer than %v, took %v", max, elapsed),
+	}, msg)
+	return r
+}
+
+// AssertDurationAtLeast runs fn and asserts that it takes at least min, such
+// as rate-limited code that must not complete too quickly.
+// The measured duration is included in the output.
+//
+// Example:
+//
+//	r.AssertDurationAtLeast(func() { limiter.Wait(ctx) }, 100*time.Millisecond)
+func (r *R) AssertDurationAtLeast(fn func(), min time.Duration, msg ...string) *R {
+	r.T.Helper()
+	elapsed := timeCall(fn)
+	r.report(check{
+		ok:      elapsed >= min,
+		pass:    fmt.Sprintf("Function took %v, at least %v", elapsed, min),
+		fail:    fmt.Sprintf("Expected function to take at least %v, took %v", min, elapsed),
+		notPass: fmt.Sprintf("Function took %v, less than %v", elapsed, min),
+		notFail: fmt.Sprintf("Expected function to take less than %v, took %v", min, elapsed),
+	}, msg)
+	return r
+}
+
+// AssertPerf asserts that fn stays within both an allocation and a time
+// budget: at most maxAllocs allocations and maxDur of wall time per call.
+// Allocations are averaged by testing.AllocsPerRun. The time is averaged over
+// a separate loop of plain calls, so it does not include the memory statistics
+// read by AllocsPerRun. fn is therefore called several times. Both
+// measurements are reported, whichever budget is exceeded. AssertMaxAllocs and
+// AssertDurationWithin check each budget alone.
+//
+// Example:
+//
+//	r.AssertPerf(func() { _ = key.String() }, 1, time.Microsecond)
+func (r *R) AssertPerf(fn func(), maxAllocs uint64, maxDur time.Duration, msg ...string) *R {
+	r.T.Helper()
+	allocs := testing.AllocsPerRun(allocRuns, fn)
+	perCall := timeCall(func() {
+		for i := 0; i < allocRuns; i++ {
+			fn()
+		}
+	}) / allocRuns
+	measured := fmt.Sprintf("%v allocations and %v per call", allocs, perCall)
+	budget := fmt.Sprintf("%d allocations and %v", maxAllocs, maxDur)
+	r.report(check{
+		ok:      allocs <= float64(maxAllocs) && perCall <= maxDur,
+		pass:    fmt.Sprintf("Function made %s, within %s", measured, budget),
+		fail:    fmt.Sprintf("Expected at most %s per call, got %s", budget, measured),
+		notPass: fmt.Sprintf("Function made %s, over %s", measured, budget),
+		notFail: fmt.Sprintf("Expected more than %s per call, got %s", budget, measured),
+	}, msg)
+	return r
+}
+
+// timeCall returns how long fn takes to run.
+func timeCall(fn func()) time.Duration {
+	start := time.Now()
+	fn()
+	return time.Since(start)
+}
+
+// Stress runs fn from concurrency goroutines at once, each calling it
+// iterations times, and waits for all of them. Each call receives a distinct
+// index from 0 to concurrency*iterations-1. A goroutine stops at its first
+// panic; the test fails if any goroutine panicked, and the panic values and
+// stacks are reported. Run the tests with -race to surface data races.
+//
+// Example:
+//
+//	cache := NewCache()
+//	r.Stress(func(i int) {
+//		cache.Set(strconv.Itoa(i%10), i)
+//		cache.Get(strconv.Itoa(i % 10))
+//	}, 8, 1000)
+func (r *R) Stress(fn func(i int), concurrency, iterations int) *R {
+	r.T.Helper()
+	var (
+		mu     sync.Mutex
+		panics []string
+		wg     sync.WaitGroup
+	)
+	for g := 0; g < concurrency; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if v := recover(); v != nil {
+					mu.Lock()
+					panics = append(panics, fmt.Sprintf("goroutine %d panicked: %v\n%s", g, v, debug.Stack()))
+					mu.Unlock()
+				}
+			}()
+			for k := 0; k < iterations; k++ {
+				fn(g*iterations + k)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if r.report(check{
+		ok:      len(panics) == 0,
+		pass:    fmt.Sprintf("%d goroutines ran %d iterations each without panicking", concurrency, iterations),
+		fail:    fmt.Sprintf("Expected no panics, but %d of %d goroutines panicked", len(panics), concurrency),
+		notPass: fmt.Sprintf("%d of %d goroutines panicked", len(panics), concurrency),
+		notFail: fmt.Sprintf("Expected a panic, but %d goroutines ran %d iterations each without panicking", concurrency, iterations),
+	}, nil) {
+		return r
+	}
+	for _, p := range panics {
+		r.Log(p)
+	}
+	return r
+}
@@ -0,0 +1,123 @@
+package got_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertNoGoroutineLeak tests goroutine leak detection
+func TestAssertNoGoroutineLeak(t *testing.T) {
+	r := got.New(t, "Test AssertNoGoroutineLeak")
+
+	r.Case("Testing goroutines that exit")
+	r.AssertNoGoroutineLeak(func() {
+		done := make(chan struct{})
+		go func() { close(done) }()
+		<-done
+	})
+
+	r.Case("Testing leaked goroutines")
+	release := make(chan struct{})
+	defer close(release)
+	leak := func() {
+		go func() { <-release }()
+	}
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNoGoroutineLeak(leak) }), "leaked goroutine should fail")
+	r.AssertNoGoroutineLeak(leak, 1)
+
+	r.Case("Testing negation")
+	r.Not().AssertNoGoroutineLeak(leak)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().AssertNoGoroutineLeak(func() {}) }), "negated check without a leak should fail")
+}
+
+var sink []byte
+
+// TestAssertMaxAllocs tests allocation budget assertions
+func TestAssertMaxAllocs(t *testing.T) {
+	r := got.New(t, "Test AssertMaxAllocs")
+
+	r.Case("Testing functions within budget")
+	r.AssertMaxAllocs(func() {}, 0)
+	r.AssertMaxAllocs(func() { sink = make([]byte, 64) }, 1)
+
+	r.Case("Testing functions over budget")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertMaxAllocs(func() {
+			sink = make([]byte, 64)
+			sink = make([]byte, 128)
+		}, 1)
+	}), "allocations over budget should fail")
+}
+
+// TestAssertPerf tests the combined allocation and time budget
+func TestAssertPerf(t *testing.T) {
+	r := got.New(t, "Test AssertPerf")
+
+	r.Case("Testing functions within budget")
+	r.AssertPerf(func() {}, 0, time.Second)
+	r.AssertPerf(func() { sink = make([]byte, 64) }, 1, time.Second)
+	calls := 0
+	r.AssertPerf(func() { calls++ }, 0, 2*time.Microsecond, "the time should not include AllocsPerRun")
+	r.AssertEqual(201, calls, "fn should be timed apart from the runs of AllocsPerRun")
+
+	r.Case("Testing functions over budget")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertPerf(func() {
+			sink = make([]byte, 64)
+			sink = make([]byte, 128)
+		}, 1, time.Second)
+	}), "allocations over budget should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertPerf(func() { time.Sleep(time.Millisecond) }, 10, 100*time.Microsecond)
+	}), "time over budget should fail")
+}
+
+// TestAssertDuration tests duration assertions
+func TestAssertDuration(t *testing.T) {
+	r := got.New(t, "Test AssertDuration")
+	nap := func() { time.Sleep(20 * time.Millisecond) }
+
+	r.Case("Testing functions within a bound")
+	r.AssertDurationWithin(func() {}, time.Second)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertDurationWithin(nap, time.Millisecond)
+	}), "slow function should fail")
+
+	r.Case("Testing functions taking a minimum time")
+	r.AssertDurationAtLeast(nap, 10*time.Millisecond)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertDurationAtLeast(func() {}, time.Second)
+	}), "fast function should fail")
+}
+
+// TestStress tests running a function concurrently
+func TestStress(t *testing.T) {
+	r := got.New(t, "Test Stress")
+
+	r.Case("Testing every index is visited once")
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	r.Stress(func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[i] = true
+	}, 4, 50)
+	r.AssertEqual(200, len(seen))
+
+	r.Case("Testing panicking goroutines fail the test")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Stress(func(i int) {
+			if i == 7 {
+				panic("boom")
+			}
+		}, 4, 5)
+	}), "panic in a goroutine should fail")
+
+	r.Case("Testing negation")
+	r.Not().Stress(func(i int) { panic("boom") }, 2, 1)
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().Stress(func(int) {}, 2, 1) }), "negated check without a panic should fail")
+}
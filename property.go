@@ -0,0 +1,65 @@
+package got
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Property checks that prop holds for runs inputs generated by gen from the
+// test's random source (see Rand). It stops at the first input for which prop
+// returns false or panics, and reports that input together with the seed, so
+// the failure can be replayed with Seed or the SeedEnv environment variable.
+//
+// Parameters:
+//   - name: The name of the property
+//   - gen: The function generating an input from the random source
+//   - prop: The property, returning whether it holds for the input
+//   - runs: The number of inputs to check
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Property("reverse twice is identity",
+//		func(rnd *rand.Rand) any { return rnd.Perm(rnd.Intn(20)) },
+//		func(in any) bool {
+//			s := in.([]int)
+//			return slices.Equal(s, reverse(reverse(s)))
+//		}, 100)
+func (r *R) Property(name string, gen func(rnd *rand.Rand) any, prop func(input any) bool, runs int) *R {
+	r.T.Helper()
+	rnd := r.Rand()
+	seed := r.root().seed
+	for i := 0; i < runs; i++ {
+		input := gen(rnd)
+		held, panicked := checkProperty(prop, input)
+		if !held {
+			reason := "does not hold"
+			if panicked != nil {
+				reason = fmt.Sprintf("panicked with %v", panicked)
+			}
+			found := fmt.Sprintf("Property %q %s on run %d of %d for input %#v (seed %d; set %s=%d to replay)",
+				name, reason, i+1, runs, input, seed, SeedEnv, seed)
+			r.report(check{ok: false, fail: found, notPass: found}, nil)
+			return r
+		}
+	}
+	r.report(check{
+		ok:      true,
+		pass:    fmt.Sprintf("Property %q held for %d runs", name, runs),
+		notFail: fmt.Sprintf("Expected property %q not to hold, but it held for %d runs", name, runs),
+	}, nil)
+	return r
+}
+
+// checkProperty calls prop with input and returns its result, or false and the
+// recovered value if it panics.
+func checkProperty(prop func(input any) bool, input any) (held bool, panicked any) {
+	defer func() {
+		if v := recover(); v != nil {
+			held, panicked = false, v
+		}
+	}()
+	return prop(input), nil
+}
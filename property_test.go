@@ -0,0 +1,44 @@
+package got_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestProperty tests the property-based testing helper
+func TestProperty(t *testing.T) {
+	r := got.New(t, "Test Property")
+	genSlice := func(rnd *rand.Rand) any { return rnd.Perm(rnd.Intn(20)) }
+
+	r.Case("Testing a property that holds")
+	calls := 0
+	r.Property("sorting is idempotent", genSlice, func(in any) bool {
+		calls++
+		s := slices.Clone(in.([]int))
+		slices.Sort(s)
+		sorted := slices.Clone(s)
+		slices.Sort(s)
+		return slices.Equal(s, sorted)
+	}, 50)
+	r.AssertEqual(50, calls)
+
+	r.Case("Testing a property that fails")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Property("always short", genSlice, func(in any) bool { return len(in.([]int)) < 5 }, 100)
+	}), "failing property should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.Property("panics", genSlice, func(in any) bool { return in.([]int)[100] > 0 }, 10)
+	}), "panicking property should fail")
+
+	r.Case("Testing the failing input and seed are reported")
+	_, out := gottest.Detached(func(pr *got.R) {
+		pr.Seed(42).Property("never", func(rnd *rand.Rand) any { return rnd.Intn(10) }, func(any) bool { return false }, 3)
+	})
+	r.AssertContainsInOrder(out, `Property "never" does not hold on run 1 of 3`, "seed 42", "GOT_SEED=42")
+	r.AssertFalse(strings.Contains(out, "[PASS]"), out)
+}
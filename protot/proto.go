@@ -0,0 +1,75 @@
+// Package protot provides assertions for protobuf messages. It lives in its
+// own package so that only the tests importing it depend on
+// google.golang.org/protobuf.
+package protot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/textdiff"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// R is a test runner with helpers for testing protobuf messages.
+// It embeds *got.R, so all the assertions of the core runner are available.
+// AssertProtoEqual does not honor Not, which returns a *got.R without it.
+//
+// Example:
+//
+//	r := protot.New(t, "User service")
+//	resp, err := client.GetUser(ctx, &pb.GetUserRequest{Id: 1})
+//	r.AssertNoErr(err)
+//	r.AssertProtoEqual(&pb.User{Id: 1, Name: "alice"}, resp)
+type R struct {
+	*got.R
+}
+
+// New creates a new protobuf test runner, like got.New.
+func New(t *testing.T, title string) *R {
+	t.Helper()
+	return &R{R: got.New(t, title)}
+}
+
+// Wrap extends an existing runner with the protobuf helpers.
+func Wrap(r *got.R) *R {
+	r.T.Helper()
+	return &R{R: r}
+}
+
+// AssertProtoEqual asserts that two protobuf messages are equal according to
+// proto.Equal. Unlike AssertEqual, it ignores the internal state of the
+// generated structs, such as size caches. On failure, the line diff of the
+// text format of the messages is reported.
+//
+// Example:
+//
+//	r.AssertProtoEqual(&pb.User{Id: 1, Name: "alice"}, resp)
+func (r *R) AssertProtoEqual(expected, actual proto.Message, msg ...string) *R {
+	r.T.Helper()
+	if proto.Equal(expected, actual) {
+		r.Pass("Messages are equal")
+		return r
+	}
+	message := fmt.Sprintf("Expected messages to be equal (- expected, + actual):\n%s", textdiff.Lines(format(expected), format(actual)))
+	if len(msg) > 0 {
+		message = msg[0]
+	}
+	r.Fail(message)
+	return r
+}
+
+// format renders m in the multi-line text format.
+func format(m proto.Message) string {
+	if m == nil {
+		return "<nil>"
+	}
+	name := string(m.ProtoReflect().Descriptor().FullName())
+	if !m.ProtoReflect().IsValid() {
+		return name + " <nil>"
+	}
+	return name + " {\n" + strings.TrimSuffix(prototext.MarshalOptions{Multiline: true, Indent: "  "}.Format(m), "\n") + "\n}"
+}
@@ -0,0 +1,43 @@
+package protot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// probe reports whether fn fails on a runner detached from the running test.
+func probe(fn func(r *R)) bool {
+	return gottest.Probe(func(r *got.R) { fn(Wrap(r)) })
+}
+
+// TestAssertProtoEqual tests comparing protobuf messages
+func TestAssertProtoEqual(t *testing.T) {
+	r := New(t, "Test AssertProtoEqual")
+
+	r.Case("Testing equal messages")
+	expected, _ := structpb.NewStruct(map[string]any{"id": 1, "name": "alice"})
+	actual, _ := structpb.NewStruct(map[string]any{"name": "alice", "id": 1})
+	_ = proto.Size(actual) // populates internal state ignored by proto.Equal
+	r.AssertProtoEqual(expected, actual).
+		AssertProtoEqual(wrapperspb.String("a"), wrapperspb.String("a"))
+
+	r.Case("Testing different messages")
+	other, _ := structpb.NewStruct(map[string]any{"id": 2, "name": "alice"})
+	r.AssertTrue(probe(func(pr *R) { pr.AssertProtoEqual(expected, other) }), "different values should fail")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertProtoEqual(wrapperspb.String("a"), wrapperspb.Int64(1)) }), "different types should fail")
+	r.AssertTrue(probe(func(pr *R) { pr.AssertProtoEqual(wrapperspb.String("a"), nil) }), "nil should fail")
+
+	r.Case("Testing the failure diff")
+	_, out := gottest.Detached(func(pr *got.R) {
+		Wrap(pr).AssertProtoEqual(wrapperspb.String("alice"), wrapperspb.String("bob"))
+	})
+	r.AssertTrue(strings.Contains(out, "google.protobuf.StringValue {"), "diff should name the message type")
+	r.AssertTrue(strings.Contains(out, `- `) && strings.Contains(out, `"alice"`), "diff should show the expected value")
+	r.AssertTrue(strings.Contains(out, `+ `) && strings.Contains(out, `"bob"`), "diff should show the actual value")
+}
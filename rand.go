@@ -0,0 +1,80 @@
+package got
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"strconv"
+)
+
+// SeedEnv is the environment variable that overrides the seed of Rand, so
+// that a failing run can be replayed with the seed it logged.
+const SeedEnv = "GOT_SEED"
+
+// Rand returns a random source for the test, created on first use. Unless
+// pinned with Seed, it is seeded from the SeedEnv environment variable if set,
+// or from the test name otherwise, so every run of a test draws the same
+// values. The seed is logged when the source is created. The source is shared
+// by the runner and its Not views, and like rand.Rand it is not safe for
+// concurrent use.
+//
+// The runner of a subtest (see Sub) has its own source, so parallel subtests
+// do not share one. If the parent's source existed when the subtest started,
+// the subtest's seed is derived from the parent's seed and the subtest name;
+// otherwise it is chosen like a top-level seed. Either way it is logged, and
+// SeedEnv overrides it.
+//
+// Returns:
+//   - *rand.Rand: The random source of the test
+//
+// Example:
+//
+//	rng := r.Rand()
+//	n := rng.Intn(100)
+//	// replay with: GOT_SEED=<logged seed> go test -run TestX
+func (r *R) Rand() *rand.Rand {
+	r.T.Helper()
+	root := r.root()
+	if root.rng == nil {
+		seed, source := nameSeed(r.Name()), "test name"
+		if p := root.baseSeed; p != nil {
+			seed, source = nameSeed(strconv.FormatInt(*p, 10)+"/"+r.Name()), "parent seed and subtest name"
+		}
+		if v := os.Getenv(SeedEnv); v != "" {
+			if s, err := strconv.ParseInt(v, 10, 64); err == nil {
+				seed, source = s, SeedEnv
+			} else {
+				r.Logf("Ignoring invalid %s %q: %v", SeedEnv, v, err)
+			}
+		}
+		r.Seed(seed)
+		r.Logf("Random seed: %d (from %s; set %s=%d to replay)", seed, source, SeedEnv, seed)
+	}
+	return root.rng
+}
+
+// Seed pins the random source returned by Rand to seed, replacing any source
+// already created.
+//
+// Parameters:
+//   - seed: The seed of the random source
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Seed(42)
+//	shuffled := r.Rand().Perm(10)
+func (r *R) Seed(seed int64) *R {
+	root := r.root()
+	root.rng, root.seed = rand.New(rand.NewSource(seed)), seed
+	return r
+}
+
+// nameSeed derives a seed from a test name.
+func nameSeed(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,66 @@
+package got_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestRand tests the deterministic random source
+func TestRand(t *testing.T) {
+	r := got.New(t, "Test Rand")
+
+	r.Case("Testing the source is stable for a test")
+	first := r.Rand().Int63()
+	r.AssertTrue(r.Rand() == r.Not().Rand(), "Not views should share the source")
+	r.Run("same name", func(tt *testing.T) {
+		a := got.New(tt, "a").Rand().Int63()
+		b := got.New(tt, "b").Rand().Int63()
+		r.AssertEqual(a, b, "runners of the same test should draw the same values")
+	})
+
+	r.Case("Testing Seed pins the source")
+	r.Seed(42)
+	pinned := r.Rand().Perm(5)
+	r.AssertEqual(pinned, r.Seed(42).Rand().Perm(5))
+	r.AssertNotEqual(first, r.Seed(7).Rand().Int63())
+
+	r.Case("Testing subtests have their own sources")
+	draws := map[string]int64{}
+	var mu sync.Mutex
+	r.Run("parallel", func(tt *testing.T) {
+		pr := r.Sub(tt).Seed(42)
+		for _, name := range []string{"a", "b"} {
+			pr.Run(name, func(st *testing.T) {
+				st.Parallel()
+				sr := pr.Sub(st)
+				r.AssertTrue(sr.Rand() != pr.Rand(), "a subtest should not share the source of its parent")
+				mu.Lock()
+				draws[name] = sr.Rand().Int63()
+				mu.Unlock()
+			})
+		}
+	})
+	r.AssertEqual(2, len(draws))
+	r.AssertNotEqual(draws["a"], draws["b"], "subtests should draw from differently seeded sources")
+	var buf bytes.Buffer
+	var seeded, again int64
+	r.Run("derived", func(tt *testing.T) {
+		pr := got.New(tt, "derived", got.WithReporter(&buf), got.WithColor(false)).Seed(42)
+		pr.Run("a", func(st *testing.T) { seeded = pr.Sub(st).Rand().Int63() })
+		pr.Seed(42).Run("b", func(st *testing.T) { again = pr.Sub(st).Rand().Int63() })
+	})
+	r.AssertContains(buf.String(), "(from parent seed and subtest name")
+	r.AssertNotEqual(seeded, again, "the subtest name should be part of the seed")
+
+	r.Case("Testing the seed is logged and can be overridden")
+	t.Setenv(got.SeedEnv, "42")
+	var perm []int
+	_, out := gottest.Detached(func(pr *got.R) { perm = pr.Rand().Perm(5) })
+	r.AssertEqual(pinned, perm)
+	r.AssertTrue(strings.Contains(out, "Random seed: 42 (from GOT_SEED"), out)
+}
@@ -0,0 +1,150 @@
+package redist
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrInjectedFault is the error returned for injected failures when
+// FaultConfig.Err is nil.
+var ErrInjectedFault = errors.New("redist: injected fault")
+
+// FaultConfig describes the faults injected by a client from NewFaultyRedis.
+type FaultConfig struct {
+	ErrorRate float64       // probability, from 0 to 1, that an affected command fails
+	Err       error         // the error returned by failing commands; ErrInjectedFault if nil
+	Latency   time.Duration // delay added before every affected command
+	Commands  []string      // names of the affected commands, e.g. "get"; all commands if empty
+	Seed      int64         // seed of the random source deciding which commands fail
+}
+
+// Faults controls the faults injected by a client from NewFaultyRedis. It is
+// safe to change the faults while commands are running.
+type Faults struct {
+	mu  sync.Mutex
+	cfg FaultConfig
+	rnd *rand.Rand
+}
+
+// Set replaces the fault configuration, for example to stop injecting errors
+// halfway through a test. The random source is reseeded with cfg.Seed.
+func (f *Faults) Set(cfg FaultConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cfg, f.rnd = cfg, rand.New(rand.NewSource(cfg.Seed))
+}
+
+// Config returns the current fault configuration.
+func (f *Faults) Config() FaultConfig {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.cfg
+}
+
+// NewFaultyRedis starts a miniredis server like NewMiniRedis and returns a
+// client whose commands are delayed and fail according to cfg, together with
+// a handle to change the faults mid-test. Use it to test retry, timeout and
+// circuit-breaker logic. Which commands fail is decided by a random source
+// seeded with cfg.Seed, so a test sees the same failures on every run.
+// Latency honors the command's context.
+//
+// Example:
+//
+//	client, faults, err := redist.NewFaultyRedis(redist.FaultConfig{ErrorRate: 0.5, Commands: []string{"get"}})
+//	// ... exercise the retry logic
+//	faults.Set(redist.FaultConfig{}) // heal the connection
+func NewFaultyRedis(cfg FaultConfig) (*redis.Client, *Faults, error) {
+	client, err := NewMiniRedis()
+	if err != nil {
+		return nil, nil, err
+	}
+	faults := &Faults{}
+	faults.Set(cfg)
+	client.AddHook(faultHook{faults: faults})
+	return client, faults, nil
+}
+
+// inject applies the faults to the commands about to run. It returns the
+// error they must fail with, or nil.
+func (f *Faults) inject(ctx context.Context, cmds []redis.Cmder) error {
+	f.mu.Lock()
+	cfg := f.cfg
+	affected := false
+	for _, cmd := range cmds {
+		if cfg.affects(cmd.Name()) {
+			affected = true
+			break
+		}
+	}
+	fail := affected && cfg.ErrorRate > 0 && f.rnd.Float64() < cfg.ErrorRate
+	f.mu.Unlock()
+	if !affected {
+		return nil
+	}
+	if cfg.Latency > 0 {
+		timer := time.NewTimer(cfg.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if !fail {
+		return nil
+	}
+	if cfg.Err != nil {
+		return cfg.Err
+	}
+	return ErrInjectedFault
+}
+
+// affects reports whether the faults apply to the command name.
+func (c FaultConfig) affects(name string) bool {
+	if len(c.Commands) == 0 {
+		return true
+	}
+	for _, cmd := range c.Commands {
+		if strings.EqualFold(cmd, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// faultHook is a redis.Hook injecting the faults of a Faults handle.
+type faultHook struct {
+	faults *Faults
+}
+
+func (h faultHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h faultHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.faults.inject(ctx, []redis.Cmder{cmd}); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (h faultHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.faults.inject(ctx, cmds); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		return next(ctx, cmds)
+	}
+}
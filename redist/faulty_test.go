@@ -0,0 +1,74 @@
+package redist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestNewFaultyRedis tests injecting errors and latency into Redis commands
+func TestNewFaultyRedis(t *testing.T) {
+	ctx := context.Background()
+	client, faults, err := NewFaultyRedis(FaultConfig{ErrorRate: 1, Commands: []string{"GET"}})
+	if err != nil {
+		t.Fatalf("NewFaultyRedis should not return error, got: %v", err)
+	}
+	defer client.Close()
+
+	if err := client.Set(ctx, "k", "v", 0).Err(); err != nil {
+		t.Errorf("Unaffected command should succeed, got: %v", err)
+	}
+	if err := client.Get(ctx, "k").Err(); !errors.Is(err, ErrInjectedFault) {
+		t.Errorf("Affected command should fail with ErrInjectedFault, got: %v", err)
+	}
+	pipe := client.Pipeline()
+	pipe.Get(ctx, "k")
+	if _, err := pipe.Exec(ctx); !errors.Is(err, ErrInjectedFault) {
+		t.Errorf("Pipeline with an affected command should fail, got: %v", err)
+	}
+
+	custom := errors.New("connection reset")
+	faults.Set(FaultConfig{ErrorRate: 1, Err: custom})
+	if err := client.Set(ctx, "k", "v", 0).Err(); !errors.Is(err, custom) {
+		t.Errorf("Command should fail with the configured error, got: %v", err)
+	}
+
+	faults.Set(FaultConfig{Latency: 20 * time.Millisecond})
+	start := time.Now()
+	if v, err := client.Get(ctx, "k").Result(); err != nil || v != "v" {
+		t.Errorf("Healed client should return the value, got: %q, %v", v, err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Command should be delayed by the latency, took %v", elapsed)
+	}
+	short, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	faults.Set(FaultConfig{Latency: time.Second})
+	if err := client.Get(short, "k").Err(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Latency should honor the context, got: %v", err)
+	}
+	if cfg := faults.Config(); cfg.Latency != time.Second {
+		t.Errorf("Config should return the current configuration, got: %+v", cfg)
+	}
+}
+
+// TestFaultyRedisErrorRate tests that errors are injected at the configured rate
+func TestFaultyRedisErrorRate(t *testing.T) {
+	ctx := context.Background()
+	client, _, err := NewFaultyRedis(FaultConfig{ErrorRate: 0.3, Seed: 7})
+	if err != nil {
+		t.Fatalf("NewFaultyRedis should not return error, got: %v", err)
+	}
+	defer client.Close()
+
+	failed := 0
+	for i := 0; i < 1000; i++ {
+		if client.Ping(ctx).Err() != nil {
+			failed++
+		}
+	}
+	if failed < 200 || failed > 400 {
+		t.Errorf("Expected about 300 of 1000 commands to fail, got %d", failed)
+	}
+}
@@ -15,7 +15,28 @@ func MockRedis() (*redis.Client, redismock.ClientMock) {
 	return redismock.NewClientMock()
 }
 
+const (
+	// pingAttempts is the number of pings NewMiniRedis makes before giving up.
+	pingAttempts = 3
+	// pingBackoff is the delay before the first retry; it doubles on each retry.
+	pingBackoff = 100 * time.Millisecond
+	// pingTimeout bounds a single ping.
+	pingTimeout = 5 * time.Second
+)
+
+// NewMiniRedis starts an in-memory miniredis server and returns a client
+// connected to it. The server is pinged up to 3 times with exponential
+// backoff; see NewMiniRedisRetry to configure the retries.
 func NewMiniRedis() (*redis.Client, error) {
+	return NewMiniRedisRetry(pingAttempts, pingBackoff)
+}
+
+// NewMiniRedisRetry is like NewMiniRedis, but pings the server up to attempts
+// times, waiting backoff before the first retry and doubling the wait on each
+// further retry. Use more attempts on slow CI machines where the first ping
+// may time out. If every attempt fails, the server is shut down and the error
+// of the last attempt is returned.
+func NewMiniRedisRetry(attempts int, backoff time.Duration) (*redis.Client, error) {
 	// miniredis for test
 	mr, err := miniredis.Run()
 	if err != nil {
@@ -30,17 +51,54 @@ func NewMiniRedis() (*redis.Client, error) {
 		MinIdleConns: 2,
 		TLSConfig:    nil,
 	})
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	_, err = client.Ping(ctx).Result()
-	if err != nil {
-		return nil, fmt.Errorf("redis error: %s", err.Error())
+	if err := pingWithRetry(client, attempts, backoff); err != nil {
+		client.Close()
+		mr.Close()
+		return nil, err
 	}
 	log.Printf("redis connected, url: %s\n", client.Conn().String())
 	return client, nil
 }
 
+// pingWithRetry pings client until it answers, making at most attempts pings
+// with exponential backoff between them.
+func pingWithRetry(client *redis.Client, attempts int, backoff time.Duration) error {
+	attempts = max(attempts, 1)
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+		err = client.Ping(ctx).Err()
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("redis error: ping failed after %d attempts: %v", attempts, err)
+}
+
+// FlushRedis removes all keys from every database of the server client is
+// connected to. Call it between subtests or cases, or register it with
+// t.Cleanup, to share one NewMiniRedis server across many tests while keeping
+// their data isolated. Sharing a server is faster than starting one per test,
+// but the tests using it must not run in parallel, as a flush in one of them
+// removes the keys of the others.
+//
+// Example:
+//
+//	t.Cleanup(func() { redist.FlushRedis(client) })
+func FlushRedis(client *redis.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	if err := client.FlushAll(ctx).Err(); err != nil {
+		return fmt.Errorf("redis error: flush failed: %v", err)
+	}
+	return nil
+}
+
 func NewRedisCluster() redis.UniversalClient {
 	// TODO: mock redis cluster
 	return nil
@@ -0,0 +1,94 @@
+package redist
+
+import (
+	"fmt"
+	"strings"
+
+	redismock "github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+)
+
+// PipeOp describes one command expected in a pipeline and its result.
+// Args holds the raw command as sent by the client, in lower or upper case,
+// e.g. []any{"set", "key", "value"} for client.Set(ctx, "key", "value", 0).
+type PipeOp struct {
+	Args []any // the command name followed by its arguments
+	Val  any   // the value returned for the command
+	Err  error // the error returned for the command, if any
+	Nil  bool  // whether the command returns redis.Nil
+}
+
+// ExpectPipelineOps registers the ops as expectations on mock, in order.
+// Arguments are matched by their string representation, so 60 matches an
+// int64(60) sent by the client. Execute the pipeline against the mocked client
+// and pass the returned commands to VerifyPipelineOps to find out which
+// command diverged.
+func ExpectPipelineOps(mock redismock.ClientMock, ops []PipeOp) {
+	for _, op := range ops {
+		e := mock.CustomMatch(matchArgs).ExpectDo(op.Args...)
+		switch {
+		case op.Err != nil:
+			e.SetErr(op.Err)
+		case op.Nil:
+			e.RedisNil()
+		default:
+			e.SetVal(op.Val)
+		}
+	}
+}
+
+// ExpectTxPipelineOps is like ExpectPipelineOps for transactional pipelines,
+// wrapping the ops in the MULTI/EXEC expectations.
+func ExpectTxPipelineOps(mock redismock.ClientMock, ops []PipeOp) {
+	mock.ExpectTxPipeline()
+	ExpectPipelineOps(mock, ops)
+	mock.ExpectTxPipelineExec()
+}
+
+// VerifyPipelineOps compares the commands executed by a pipeline with the
+// expected ops, in order, and returns an error describing the first command
+// that diverged, or nil if all of them match.
+func VerifyPipelineOps(cmds []redis.Cmder, ops []PipeOp) error {
+	for i, cmd := range cmds {
+		if i >= len(ops) {
+			return fmt.Errorf("pipeline command %d %v was not expected", i, cmd.Args())
+		}
+		op := ops[i]
+		if err := cmd.Err(); err != nil && err != op.Err && !(op.Nil && err == redis.Nil) {
+			return fmt.Errorf("pipeline command %d diverged: expected %v, got %v: %v", i, op.Args, cmd.Args(), err)
+		}
+		if !sameArgs(op.Args, cmd.Args()) {
+			return fmt.Errorf("pipeline command %d diverged: expected %v, got %v", i, op.Args, cmd.Args())
+		}
+	}
+	if len(cmds) < len(ops) {
+		return fmt.Errorf("pipeline command %d %v was expected but not executed", len(cmds), ops[len(cmds)].Args)
+	}
+	return nil
+}
+
+// matchArgs is a redismock.CustomMatch comparing arguments with sameArgs.
+func matchArgs(expected, actual []any) error {
+	if !sameArgs(expected, actual) {
+		return fmt.Errorf("args do not match, expectation '%v', but gave '%v'", expected, actual)
+	}
+	return nil
+}
+
+// sameArgs compares command arguments by their string representation, as
+// they are sent over the wire, ignoring the case of the command name.
+func sameArgs(expected, actual []any) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		e, a := fmt.Sprint(expected[i]), fmt.Sprint(actual[i])
+		if i == 0 {
+			e, a = strings.ToLower(e), strings.ToLower(a)
+		}
+		if e != a {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package redist
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestExpectPipelineOps tests pipelined command expectations
+func TestExpectPipelineOps(t *testing.T) {
+	ctx := context.Background()
+	ops := []PipeOp{
+		{Args: []any{"set", "user:1", "alice"}, Val: "OK"},
+		{Args: []any{"get", "user:1"}, Val: "alice"},
+		{Args: []any{"get", "user:2"}, Nil: true},
+	}
+
+	client, mock := MockRedis()
+	ExpectPipelineOps(mock, ops)
+	pipe := client.Pipeline()
+	pipe.Set(ctx, "user:1", "alice", 0)
+	get := pipe.Get(ctx, "user:1")
+	pipe.Get(ctx, "user:2")
+	cmds, _ := pipe.Exec(ctx)
+	if err := VerifyPipelineOps(cmds, ops); err != nil {
+		t.Errorf("VerifyPipelineOps should not return error, got: %v", err)
+	}
+	if get.Val() != "alice" {
+		t.Errorf("Expected pipelined GET to return alice, got: %s", get.Val())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+
+	// Test a diverging command is reported with its index
+	client, mock = MockRedis()
+	ExpectPipelineOps(mock, ops)
+	pipe = client.Pipeline()
+	pipe.Set(ctx, "user:1", "alice", 0)
+	pipe.Get(ctx, "user:3")
+	cmds, _ = pipe.Exec(ctx)
+	err := VerifyPipelineOps(cmds, ops)
+	if err == nil || !strings.Contains(err.Error(), "command 1") {
+		t.Errorf("VerifyPipelineOps should report command 1 diverged, got: %v", err)
+	}
+
+	// Test missing commands are reported
+	err = VerifyPipelineOps(cmds[:1], ops[:2])
+	if err == nil || !strings.Contains(err.Error(), "not executed") {
+		t.Errorf("VerifyPipelineOps should report missing commands, got: %v", err)
+	}
+}
+
+// TestExpectTxPipelineOps tests transactional pipeline expectations
+func TestExpectTxPipelineOps(t *testing.T) {
+	ctx := context.Background()
+	ops := []PipeOp{
+		{Args: []any{"incr", "counter"}, Val: int64(1)},
+		{Args: []any{"expire", "counter", 60}, Val: true},
+	}
+
+	client, mock := MockRedis()
+	ExpectTxPipelineOps(mock, ops)
+	pipe := client.TxPipeline()
+	pipe.Incr(ctx, "counter")
+	pipe.Expire(ctx, "counter", 60e9)
+	cmds, err := pipe.Exec(ctx)
+	if err != nil {
+		t.Errorf("Exec should not return error, got: %v", err)
+	}
+	if err := VerifyPipelineOps(cmds, ops); err != nil {
+		t.Errorf("VerifyPipelineOps should not return error, got: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
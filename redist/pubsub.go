@@ -0,0 +1,44 @@
+package redist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Subscribe subscribes client to channels and waits until the server has
+// confirmed the subscription, so that messages published afterwards are not
+// lost. The caller must close the returned PubSub.
+func Subscribe(ctx context.Context, client *redis.Client, channels ...string) (*redis.PubSub, error) {
+	sub := client.Subscribe(ctx, channels...)
+	for range channels {
+		if _, err := sub.Receive(ctx); err != nil {
+			_ = sub.Close()
+			return nil, fmt.Errorf("subscribe to %v error: %v", channels, err)
+		}
+	}
+	return sub, nil
+}
+
+// WaitForMessage receives one message from sub, waiting at most timeout.
+// It returns an error if no message arrives in time or ctx is done, which
+// keeps Pub/Sub tests from hanging or flaking on timing.
+func WaitForMessage(ctx context.Context, sub *redis.PubSub, timeout time.Duration) (*redis.Message, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf("no message received within %v", timeout)
+		}
+		msg, err := sub.ReceiveTimeout(ctx, remaining)
+		if err != nil {
+			return nil, fmt.Errorf("no message received within %v: %v", timeout, err)
+		}
+		// skip subscription confirmations and pongs
+		if m, ok := msg.(*redis.Message); ok {
+			return m, nil
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package redist
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestPubSub tests publishing and receiving through the miniredis client
+func TestPubSub(t *testing.T) {
+	client, err := NewMiniRedis()
+	if err != nil {
+		t.Fatalf("NewMiniRedis should not return error, got: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	sub, err := Subscribe(ctx, client, "events")
+	if err != nil {
+		t.Fatalf("Subscribe should not return error, got: %v", err)
+	}
+	defer sub.Close()
+
+	if err := client.Publish(ctx, "events", "created").Err(); err != nil {
+		t.Errorf("Publish should not return error, got: %v", err)
+	}
+
+	msg, err := WaitForMessage(ctx, sub, time.Second)
+	if err != nil {
+		t.Fatalf("WaitForMessage should not return error, got: %v", err)
+	}
+	if msg.Channel != "events" || msg.Payload != "created" {
+		t.Errorf("Expected message 'created' on 'events', got %q on %q", msg.Payload, msg.Channel)
+	}
+
+	// Test timeout when nothing is published
+	if _, err := WaitForMessage(ctx, sub, 50*time.Millisecond); err == nil {
+		t.Error("WaitForMessage should return error when no message arrives")
+	}
+}
@@ -3,6 +3,7 @@ package redist
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -244,3 +245,61 @@ func TestRedisConcurrency(t *testing.T) {
 		}
 	}
 }
+
+// TestNewMiniRedisRetry tests connecting with retries
+func TestNewMiniRedisRetry(t *testing.T) {
+	client, err := NewMiniRedisRetry(5, time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewMiniRedisRetry should not return error, got: %v", err)
+	}
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Errorf("Ping should not return error, got: %v", err)
+	}
+}
+
+// TestPingWithRetry tests giving up after the configured attempts
+func TestPingWithRetry(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer client.Close()
+
+	start := time.Now()
+	err := pingWithRetry(client, 3, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("pingWithRetry should fail for an unreachable server")
+	}
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("Error should mention the number of attempts, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected backoff of at least 30ms between attempts, took %v", elapsed)
+	}
+}
+
+// TestFlushRedis tests clearing a shared server between subtests
+func TestFlushRedis(t *testing.T) {
+	client, err := NewMiniRedis()
+	if err != nil {
+		t.Fatalf("NewMiniRedis should not return error, got: %v", err)
+	}
+	ctx := context.Background()
+
+	for _, name := range []string{"first", "second"} {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := FlushRedis(client); err != nil {
+					t.Errorf("FlushRedis should not return error, got: %v", err)
+				}
+			})
+			n, err := client.DBSize(ctx).Result()
+			if err != nil {
+				t.Fatalf("DBSize failed: %v", err)
+			}
+			if n != 0 {
+				t.Errorf("Expected an empty server at the start of the subtest, got %d keys", n)
+			}
+			if err := client.Set(ctx, "shared-key", name, 0).Err(); err != nil {
+				t.Errorf("Set failed: %v", err)
+			}
+		})
+	}
+}
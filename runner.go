@@ -23,16 +23,29 @@
 //		r.Errf(err, "Division by zero should return error")
 //	}
 //
+// Wrap(t) is a shorthand for New(t, t.Name()) when the test name is a good
+// enough title for the suite.
+//
 // For more examples, see the example functions in this package.
 package got
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -63,9 +76,29 @@ func checkColorSupport() bool {
 //   - title: The main test suite title
 //   - caseNum: Current case number for automatic numbering
 //   - prefix: Formatted prefix for case logging
+//   - path: Case numbers of the enclosing cases, such as "1.2."
+//   - depth: Nesting depth of the subtest the runner was created for by Run
+//   - subject: The subject of the enclosing Describe calls
 //   - startTime: Test start time for timing
 //   - benchmark: Whether running in benchmark mode
 //   - parallel: Whether test is marked as parallel
+//   - negate: Whether assertions are inverted (see Not)
+//   - failures: Number of failed assertions reported through Fail
+//   - base: The runner a Not view was derived from
+//   - buf: Buffered output lines when buffering is enabled
+//   - clock: The clock installed with WithFakeClock, if any
+//   - cfg: The configuration resolved from the options passed to New
+//   - timings: Durations of the subtests run through the runner
+//   - xfail: The reason passed to ExpectFailure, until the next assertion
+//   - rng: The random source returned by Rand
+//   - seed: The seed of rng
+//   - baseSeed: The seed of the parent's source when a subtest runner was created, if any
+//   - hooks: The callbacks registered with BeforeEach and AfterEach
+//   - only: The filter set with Only on the rows run by Cases
+//   - spawned: The goroutines started with Go, until Wait
+//   - active: The runner of the subtest running synchronously, if any
+//   - subs: The runners of the subtests started through the runner
+//   - row: The held output of the row of Cases run with Ordered, if any
 //
 // Example:
 //
@@ -76,18 +109,41 @@ type R struct {
 	title     string
 	caseNum   int
 	prefix    string
+	path      string
+	depth     int
+	subject   string
 	startTime time.Time
 	benchmark bool
 	parallel  bool
+	negate    bool
+	failures  int
+	base      *R
+	buf       *logBuffer
+	clock     Clock
+	cfg       config
+	timings   *timingLog
+	xfail     *string
+	rng       *rand.Rand
+	seed      int64
+	baseSeed  *int64
+	hooks     *caseHooks
+	only      *regexp.Regexp
+	spawned   *goroutineGroup
+	active    *R
+	subs      *subRunners
+	row       *logBuffer
 	*testing.T
 }
 
 // New creates a new test runner instance from a testing.T.
 // The title parameter is used to identify the test suite in logs and output.
+// Options such as WithColor, WithBuffered and WithReporter configure the
+// runner; without options, the runner behaves as before.
 //
 // Parameters:
 //   - t: The testing.T instance from the test function
 //   - title: A descriptive title for the test suite
+//   - opts: Optional settings for the runner
 //
 // Returns:
 //   - *R: A new test runner instance
@@ -98,18 +154,61 @@ type R struct {
 //		r := got.New(t, "My Feature Tests")
 //		// Use r for test cases and assertions
 //	}
-func New(t *testing.T, title string) *R {
-	t.Log("Test Case => " + title)
-	return &R{
+//
+//	r := got.New(t, "Parallel Suite", got.WithBuffered(), got.WithColor(false))
+func New(t *testing.T, title string, opts ...Option) *R {
+	t.Helper()
+	r := &R{
 		T:         t,
 		title:     title,
 		startTime: time.Now(),
+		timings:   &timingLog{},
+		spawned:   &goroutineGroup{},
+		subs:      &subRunners{byT: map[*testing.T]*R{}},
+	}
+	for _, opt := range opts {
+		opt(&r.cfg)
+	}
+	if r.cfg.buffered {
+		r.SetBuffered(true)
+		r.buf.quiet = r.cfg.quiet
+	}
+	r.Log("Test Case => " + title)
+	return r
+}
+
+// Wrap creates a new test runner from a testing.T, optionally with a title.
+// It behaves exactly like New, including the initial "Test Case => title" log
+// and the initialization of the start time. When no title is given, the test's
+// name is used as the title, so Wrap(t) is equivalent to New(t, t.Name()).
+//
+// Parameters:
+//   - t: The testing.T instance from the test function
+//   - title: An optional descriptive title for the test suite
+//
+// Returns:
+//   - *R: A new test runner instance
+//
+// Example:
+//
+//	func TestMyFeature(t *testing.T) {
+//		r := got.Wrap(t)                     // title is "TestMyFeature"
+//		r2 := got.Wrap(t, "My Feature Tests") // same as got.New(t, "My Feature Tests")
+//	}
+func Wrap(t *testing.T, title ...string) *R {
+	t.Helper()
+	if len(title) > 0 {
+		return New(t, title[0])
 	}
+	return New(t, t.Name())
 }
 
 // Case starts a new test case with a descriptive message.
 // It automatically increments the case number and logs the case description.
 // The method supports printf-style formatting for dynamic case descriptions.
+// Cases started inside a subtest run with Run, Caser or Cases are numbered
+// below the enclosing case, as in "Case 1.2 -> ". Starting a case runs the
+// callbacks registered with BeforeEach and AfterEach (see AfterEach).
 //
 // Parameters:
 //   - format: A format string describing the test case
@@ -123,12 +222,20 @@ func New(t *testing.T, title string) *R {
 //	r.Case("Testing user authentication with valid credentials")
 //	r.Case("Testing division by zero with divisor %d", 0)
 func (r *R) Case(format string, args ...any) *R {
-	r.caseNum++
-	r.prefix = "Case " + strconv.Itoa(r.caseNum) + " -> "
-	r.Logf(r.prefix+format, args...)
+	r.T.Helper()
+	r.Logf(r.nextCase()+format, args...)
+	r.scope().startCase()
 	return r
 }
 
+// nextCase numbers a new case in the current scope and returns its prefix.
+func (r *R) nextCase() string {
+	s := r.scope()
+	s.caseNum++
+	s.prefix = "Case " + s.path + strconv.Itoa(s.caseNum) + " -> "
+	return s.prefix
+}
+
 // Caser runs a test case with the given name and function.
 // It combines Case and Run methods to create a named subtest with automatic case logging.
 // This is useful for organizing related test scenarios under a common name.
@@ -149,12 +256,17 @@ func (r *R) Case(format string, args ...any) *R {
 func (r *R) Caser(name string, f func(t *testing.T)) *R {
 	r.Case(name)
 	r.Run(name, f)
+	s := r.scope()
+	s.endCase(s.depth)
 	return r
 }
 
 // Run executes a subtest with the given name and function.
 // It wraps testing.T.Run to provide a convenient way to run subtests while
-// maintaining the fluent API pattern.
+// maintaining the fluent API pattern. The subtest gets its own runner, returned
+// by Sub, which numbers its cases below the current case. Until f returns or
+// calls t.Parallel, cases started on the runner are numbered by the subtest's
+// runner too. The duration of the subtest is recorded for TimingReport.
 //
 // Parameters:
 //   - name: The name of the subtest
@@ -171,9 +283,37 @@ func (r *R) Caser(name string, f func(t *testing.T)) *R {
 //		r.NoErrf(err, "Database connection should succeed")
 //	})
 func (r *R) Run(name string, f func(t *testing.T)) *R {
-	r.T.Run(name, func(tt *testing.T) {
-		f(tt)
-	})
+	r.run(name, func(sr *R) { f(sr.T) })
+	return r
+}
+
+// Group runs fn as a labeled group of assertions and logs whether all of them
+// passed. Unlike Run, the group does not start a subtest: fn runs on the same
+// goroutine and receives r itself, so its failures fail the test as usual.
+//
+// Parameters:
+//   - name: The name of the group
+//   - fn: The function making the assertions of the group
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Group("response headers", func(g *got.R) {
+//		g.AssertEqual("application/json", h.Get("Content-Type"))
+//		g.AssertEqual("no-cache", h.Get("Cache-Control"))
+//	})
+func (r *R) Group(name string, fn func(g *R)) *R {
+	r.T.Helper()
+	before := r.root().failures
+	r.Logf("Group: %s", name)
+	fn(r)
+	if failed := r.root().failures - before; failed > 0 {
+		r.Logf("%s: failed (%d of its assertions failed)", name, failed)
+	} else {
+		r.Logf("%s: passed", name)
+	}
 	return r
 }
 
@@ -186,9 +326,16 @@ func (r *R) Run(name string, f func(t *testing.T)) *R {
 //   - Runs the case as a subtest using Run()
 //   - Passes the case data to the test function
 //
+// If a case implements PanicCase, the runner also asserts that the test
+// function panics, or does not panic, as WantPanic says. Pass Ordered to
+// write the output of the rows in slice order when they run in parallel.
+// Each row is a subtest named after the case, with spaces replaced with
+// underscores, so a single row can be run with -run, or selected with Only.
+//
 // Parameters:
 //   - cases: A slice of Case implementations containing test data
 //   - f: The test function that will be executed for each case
+//   - opts: Options such as Ordered
 //
 // Example:
 //
@@ -200,20 +347,104 @@ func (r *R) Run(name string, f func(t *testing.T)) *R {
 //		result := len(c.Input().(string))
 //		r.Require(result == c.Want().(int), "Length should match expected")
 //	})
-func (r *R) Cases(cases []Case, f func(c Case, tt *testing.T)) {
-	for _, c := range cases {
-		r.Case(c.Name())
-		r.Run(c.Name(), func(tt *testing.T) {
-			f(c, tt)
+func (r *R) Cases(cases []Case, f func(c Case, tt *testing.T), opts ...CasesOption) {
+	r.T.Helper()
+	var cfg casesConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	cases = r.selectCases(cases)
+	var out *rowOutput
+	if cfg.ordered {
+		out = newRowOutput(len(cases))
+	}
+	for i, c := range cases {
+		if out != nil {
+			out.rows[i].lines = []string{r.nextCase() + c.Name()}
+			r.scope().startCase()
+		} else {
+			r.Case(c.Name())
+		}
+		r.run(c.Name(), func(sr *R) {
+			r.T.Helper()
+			if out != nil {
+				sr.row = out.rows[i]
+				defer r.complete(out, i)
+			}
+			if want, ok := wantPanic(c); ok {
+				sr.assertCasePanic(want, func() { f(c, sr.T) })
+				return
+			}
+			f(c, sr.T)
 		})
+		s := r.scope()
+		s.endCase(s.depth)
+	}
+}
+
+// assertCasePanic runs body under recover and reports whether it panicked as
+// want says. Nothing is reported if body stops the subtest with FailNow or
+// SkipNow, since it neither panicked nor returned.
+func (r *R) assertCasePanic(want bool, body func()) {
+	r.T.Helper()
+	returned := false
+	defer func() {
+		r.T.Helper()
+		v := recover()
+		if v == nil && !returned {
+			return
+		}
+		switch {
+		case want && v != nil:
+			r.Pass("Case panicked as expected: %v", v)
+		case want:
+			r.Fail("Expected the case to panic, but it returned normally")
+		case v != nil:
+			r.Fail("Expected the case not to panic, but it panicked with: %v", v)
+		default:
+			r.Pass("Case did not panic")
+		}
+	}()
+	body()
+	returned = true
+}
+
+// CasesMap runs a set of named test cases like Cases, with the same options.
+// The map key is used as the case name, overriding the name stored in the case
+// itself. Since map iteration order is random, the cases are run in sorted key
+// order.
+//
+// Parameters:
+//   - cases: A map of case name to Case implementation
+//   - f: The test function that will be executed for each case
+//
+// Example:
+//
+//	r.CasesMap(map[string]got.Case{
+//		"valid": got.NewCase("", "hello", 5, false, nil),
+//		"empty": got.NewCase("", "", 0, false, nil),
+//	}, func(c got.Case, tt *testing.T) {
+//		r.AssertEqual(c.Want(), len(c.Input().(string)))
+//	})
+func (r *R) CasesMap(cases map[string]Case, f func(c Case, tt *testing.T), opts ...CasesOption) {
+	names := make([]string, 0, len(cases))
+	for name := range cases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]Case, 0, len(cases))
+	for _, name := range names {
+		list = append(list, &namedCase{Case: cases[name], name: name})
 	}
+	r.Cases(list, f, opts...)
 }
 
 // Pass logs a successful assertion with a green checkmark.
 // Use this method to indicate that a test condition has passed.
 //
 // Parameters:
-//   - format: A format string describing the successful assertion
+//   - format: A format string describing the successful assertion, logged verbatim
+//     when there are no args
 //   - args: Arguments for the format string
 //
 // Example:
@@ -221,19 +452,27 @@ func (r *R) Cases(cases []Case, f func(c Case, tt *testing.T)) {
 //	r.Pass("User authentication succeeded")
 //	r.Pass("Value %d is within expected range", 42)
 func (r *R) Pass(format string, args ...any) {
-	if checkColorSupport() {
-		r.Logf("\t%s "+format, prependTag(checkMark, args...)...)
+	r.T.Helper()
+	if reason, ok := r.takeExpectedFailure(); ok {
+		r.Fail("Expected failure did not occur (%s): %s; remove the ExpectFailure marker",
+			reason, formatMessage(format, args))
+		return
+	}
+	if r.color() {
+		r.Logf("%s", formatTagged(checkMark, format, args))
 	} else {
-		r.Logf("\t[PASS] "+format, args...)
+		r.Logf("%s", formatTagged("[PASS]", format, args))
 	}
 }
 
 // Fail logs a failed assertion with a red X mark.
 // Use this method to indicate that a test condition has failed.
-// This method will mark the test as failed but will not stop execution.
+// This method will mark the test as failed but will not stop execution,
+// unless StopOnFirstFailure is on.
 //
 // Parameters:
-//   - format: A format string describing the failed assertion
+//   - format: A format string describing the failed assertion, logged verbatim
+//     when there are no args
 //   - args: Arguments for the format string
 //
 // Example:
@@ -241,11 +480,31 @@ func (r *R) Pass(format string, args ...any) {
 //	r.Fail("User authentication should have succeeded")
 //	r.Fail("Value %d is outside expected range", 100)
 func (r *R) Fail(format string, args ...any) {
-	if checkColorSupport() {
-		r.Errorf("\t%s "+format, prependTag(ballotX, args...)...)
+	r.T.Helper()
+	r.fail(format, args...)
+}
+
+// fail implements Fail and reports whether it failed the test, which it does
+// not for a known issue marked with ExpectFailure. Assertions that stop the
+// test after a failure must only stop it when fail returns true.
+func (r *R) fail(format string, args ...any) bool {
+	r.T.Helper()
+	if reason, ok := r.takeExpectedFailure(); ok {
+		r.Pass("Known issue (%s): %s", reason, formatMessage(format, args))
+		return false
+	}
+	r.root().failures++
+	if r.color() {
+		r.Errorf("%s", formatTagged(ballotX, format, args))
 	} else {
-		r.Errorf("\t[FAIL] "+format, args...)
+		r.Errorf("%s", formatTagged("[FAIL]", format, args))
 	}
+	if r.root().cfg.stop {
+		// Stop the innermost running subtest: FailNow must be called from the
+		// goroutine of the test it stops.
+		r.scope().T.FailNow()
+	}
+	return true
 }
 
 // Fatal logs a fatal error and immediately stops test execution.
@@ -253,7 +512,8 @@ func (r *R) Fail(format string, args ...any) {
 // Use this when a test cannot continue due to a critical failure.
 //
 // Parameters:
-//   - format: A format string describing the fatal error
+//   - format: A format string describing the fatal error, logged verbatim
+//     when there are no args
 //   - args: Arguments for the format string
 //
 // Example:
@@ -261,22 +521,103 @@ func (r *R) Fail(format string, args ...any) {
 //	r.Fatal("Database connection failed - cannot continue test")
 //	r.Fatal("Critical system component %s is not available", "auth-service")
 func (r *R) Fatal(format string, args ...any) {
-	if checkColorSupport() {
-		r.Fatalf("\t%s "+format, prependTag(ballotX, args...)...)
+	r.T.Helper()
+	if r.color() {
+		r.Fatalf("%s", formatTagged(ballotX, format, args))
 	} else {
-		r.Fatalf("\t[FATAL] "+format, args...)
+		r.Fatalf("%s", formatTagged("[FATAL]", format, args))
 	}
 }
 
-func prependTag(tag any, args ...any) []any {
+// formatTagged formats a Pass, Fail or Fatal message preceded by tag. Without
+// args, format is used verbatim rather than as a format string, so that
+// messages containing a literal %, such as "100% done" or a message built
+// with fmt.Sprintf, are not mangled into %!(NOVERB) output.
+func formatTagged(tag, format string, args []any) string {
+	return "\t" + tag + " " + formatMessage(format, args)
+}
+
+// formatMessage formats a Pass, Fail or Fatal message, using format verbatim
+// when there are no args.
+func formatMessage(format string, args []any) string {
 	if len(args) == 0 {
-		return []any{tag}
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// check describes the outcome of an assertion together with the messages to
+// report for it, both as written and when inverted by Not.
+type check struct {
+	ok      bool   // whether the assertion holds
+	pass    string // message logged when the assertion holds
+	fail    string // message logged when the assertion does not hold
+	notPass string // message logged when the negated assertion holds
+	notFail string // message logged when the negated assertion does not hold
+}
+
+// report logs the outcome of c through Pass or Fail, honoring negation.
+// A custom message in msg replaces the failure message.
+// It returns whether the (possibly negated) assertion passed.
+func (r *R) report(c check, msg []string) bool {
+	r.T.Helper()
+	passed, _ := r.reportFailed(c, msg)
+	return passed
+}
+
+// reportFailed is like report, but also reports whether the test was failed,
+// which it is not when the failure was expected by ExpectFailure. Assertions
+// that stop the test on failure use it.
+func (r *R) reportFailed(c check, msg []string) (passed, failed bool) {
+	r.T.Helper()
+	ok, pass, fail := c.ok, c.pass, c.fail
+	if r.negate {
+		ok, pass, fail = !ok, c.notPass, c.notFail
+	}
+	if ok {
+		r.Pass(pass)
+		return true, false
+	}
+	if len(msg) > 0 {
+		fail = msg[0]
+	}
+	return false, r.fail(fail)
+}
+
+// Not returns a view of the runner whose Assert* methods are inverted: an
+// assertion passes when its condition does not hold, and the reported messages
+// are phrased accordingly. Calling Not on a negated view restores the original
+// behavior. The view shares the underlying testing.T with the runner, and the
+// methods called on it return the view, so chained assertions stay negated.
+//
+// MustNoErrors, the Expect matchers, Property, Wait, Stress and
+// AssertNoGoroutineLeak are inverted as well. Pass, Fail, Fatal, Require,
+// FailNow, Must and NoPanic report as written, and an assertion given invalid
+// arguments, such as a non-slice passed to AssertSorted or an invalid regular
+// expression, fails whether it is negated or not. The assertions of the
+// gothttp, protot, sqlt and yamlt packages do not honor Not.
+//
+// Returns:
+//   - *R: A negated view of the runner
+//
+// Example:
+//
+//	r.Not().AssertContains("hello world", "foo") // passes
+//	r.Not().AssertEqual(1, 2).AssertNil(err)     // both negated
+func (r *R) Not() *R {
+	n := *r
+	n.negate = !r.negate
+	n.base = r.root()
+	return &n
+}
+
+// root returns the runner that holds the shared state, which is the runner
+// itself unless r is a view created by Not.
+func (r *R) root() *R {
+	if r.base != nil {
+		return r.base
 	}
-	// Pre-allocate slice with exact capacity to avoid reallocation
-	result := make([]any, 0, len(args)+1)
-	result = append(result, tag)
-	result = append(result, args...)
-	return result
+	return r
 }
 
 // Require is a convenient assertion method that checks a boolean condition.
@@ -294,6 +635,7 @@ func prependTag(tag any, args ...any) []any {
 //	r.Require(len(items) > 0, "Items list should not be empty")
 //	r.Require(result == expected, "Result %d should equal %d", result, expected)
 func (r *R) Require(cond bool, desc string, args ...any) {
+	r.T.Helper()
 	if cond {
 		r.Pass(desc, args...)
 	} else {
@@ -316,14 +658,11 @@ func (r *R) Require(cond bool, desc string, args ...any) {
 //	r.FailNow(db.IsConnected(), "Database connection is required for this test")
 //	r.FailNow(config.IsValid(), "Configuration must be valid to continue")
 func (r *R) FailNow(cond bool, desc string, args ...any) {
+	r.T.Helper()
 	if cond {
 		r.Pass(desc, args...)
-	} else {
-		r.Fail(desc, args...)
+	} else if r.fail(desc, args...) {
 		r.T.FailNow()
-		// This line should never be reached due to FailNow above
-		// But we add it as a safety measure
-		return
 	}
 }
 
@@ -339,6 +678,7 @@ func (r *R) FailNow(cond bool, desc string, args ...any) {
 //	_, err := someFunction()
 //	r.AssertNoErr(err)
 func (r *R) AssertNoErr(err error) {
+	r.T.Helper()
 	r.AssertNoErrf(err, "error unexpected")
 }
 
@@ -357,11 +697,19 @@ func (r *R) AssertNoErr(err error) {
 //	user, err := authenticateUser(username, password)
 //	r.AssertNoErrf(err, "User authentication should succeed for %s", username)
 func (r *R) AssertNoErrf(err error, desc string, args ...any) {
-	if err == nil {
-		r.Pass(desc, args...)
-	} else {
-		r.Fail(desc, args...)
-		r.Logf("requires no error, but found: %v", err)
+	r.T.Helper()
+	desc = formatMessage(desc, args)
+	_, failed := r.reportFailed(check{
+		ok:      err == nil,
+		pass:    desc,
+		fail:    desc,
+		notPass: fmt.Sprintf("%s: found error %v", desc, err),
+		notFail: fmt.Sprintf("%s: expected an error, but found nil", desc),
+	}, nil)
+	if failed {
+		if err != nil {
+			r.Logf("requires no error, but found: %v", err)
+		}
 		r.T.FailNow()
 	}
 }
@@ -378,6 +726,7 @@ func (r *R) AssertNoErrf(err error, desc string, args ...any) {
 //	_, err := divide(10, 0)
 //	r.AssertErr(err) // Expects an error for division by zero
 func (r *R) AssertErr(err error) {
+	r.T.Helper()
 	r.AssertErrf(err, "error expected")
 }
 
@@ -396,17 +745,96 @@ func (r *R) AssertErr(err error) {
 //	_, err := validateInput("")
 //	r.AssertErrf(err, "Empty input should cause validation error")
 func (r *R) AssertErrf(err error, desc string, args ...any) {
-	if err == nil {
-		r.Fail(desc, args...)
-		r.Logf("requires error, but found nil")
+	r.T.Helper()
+	desc = formatMessage(desc, args)
+	_, failed := r.reportFailed(check{
+		ok:      err != nil,
+		pass:    desc,
+		fail:    desc,
+		notPass: fmt.Sprintf("%s: found no error", desc),
+		notFail: fmt.Sprintf("%s: expected no error, but found %v", desc, err),
+	}, nil)
+	if failed {
+		if err == nil {
+			r.Logf("requires error, but found nil")
+		}
 		r.T.FailNow()
-	} else {
-		r.Pass(desc, args...)
 	}
 }
 
+// AssertNoErrors checks that every provided error is nil.
+// If all errors are nil, it passes the test; otherwise, it fails and logs each
+// non-nil error together with its index. Unlike AssertNoErrf, it does not stop
+// the test, so all failures can be seen at once. Use MustNoErrors to stop.
+//
+// Parameters:
+//   - errs: The errors to check
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	errA := setupA()
+//	errB := setupB()
+//	r.AssertNoErrors(errA, errB)
+func (r *R) AssertNoErrors(errs ...error) *R {
+	r.T.Helper()
+	r.checkNoErrors(errs)
+	return r
+}
+
+// MustNoErrors checks that every provided error is nil like AssertNoErrors,
+// but stops the test execution if any of them is not nil.
+//
+// Parameters:
+//   - errs: The errors to check
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.MustNoErrors(db.Ping(), cache.Ping())
+func (r *R) MustNoErrors(errs ...error) *R {
+	r.T.Helper()
+	if r.checkNoErrors(errs) {
+		r.T.FailNow()
+	}
+	return r
+}
+
+// checkNoErrors checks that all errs are nil, logging each non-nil one when
+// the check fails, and reports whether it failed the test. It does not for a
+// failure expected by ExpectFailure, so that MustNoErrors goes on.
+func (r *R) checkNoErrors(errs []error) bool {
+	r.T.Helper()
+	found := 0
+	for _, err := range errs {
+		if err != nil {
+			found++
+		}
+	}
+	passed, failed := r.reportFailed(check{
+		ok:      found == 0,
+		pass:    fmt.Sprintf("No errors found in %d values", len(errs)),
+		fail:    fmt.Sprintf("Expected no errors, but found %d of %d", found, len(errs)),
+		notPass: fmt.Sprintf("Found %d errors in %d values", found, len(errs)),
+		notFail: fmt.Sprintf("Expected errors, but found none in %d values", len(errs)),
+	}, nil)
+	if !passed && found > 0 {
+		for i, err := range errs {
+			if err != nil {
+				r.Logf("\terror[%d]: %v", i, err)
+			}
+		}
+	}
+	return failed
+}
+
 // StartTimer starts timing the test
 func (r *R) StartTimer() *R {
+	r.T.Helper()
 	r.startTime = time.Now()
 	r.Case("Starting test timer")
 	return r
@@ -414,6 +842,7 @@ func (r *R) StartTimer() *R {
 
 // StopTimer stops timing and logs the duration
 func (r *R) StopTimer() *R {
+	r.T.Helper()
 	duration := time.Since(r.startTime)
 	r.Case("Test completed in %v", duration)
 	return r
@@ -421,6 +850,7 @@ func (r *R) StopTimer() *R {
 
 // Benchmark starts a benchmark test
 func (r *R) Benchmark(name string, f func(b *testing.B)) *R {
+	r.T.Helper()
 	r.Case("Benchmark: %s", name)
 	r.benchmark = true
 	r.Run(name, func(t *testing.T) {
@@ -434,6 +864,7 @@ func (r *R) Benchmark(name string, f func(b *testing.B)) *R {
 
 // Parallel marks the test as safe to run in parallel
 func (r *R) Parallel() *R {
+	r.T.Helper()
 	r.parallel = true
 	r.T.Parallel()
 	r.Case("Test marked as parallel")
@@ -442,6 +873,7 @@ func (r *R) Parallel() *R {
 
 // Skip skips the current test with a reason
 func (r *R) Skip(reason string, args ...any) *R {
+	r.T.Helper()
 	r.Case("Skipping test: "+reason, args...)
 	r.T.Skipf(reason, args...)
 	return r
@@ -449,6 +881,7 @@ func (r *R) Skip(reason string, args ...any) *R {
 
 // SkipIf skips the test if the condition is true
 func (r *R) SkipIf(condition bool, reason string, args ...any) *R {
+	r.T.Helper()
 	if condition {
 		r.Skip(reason, args...)
 	}
@@ -457,6 +890,7 @@ func (r *R) SkipIf(condition bool, reason string, args ...any) *R {
 
 // SkipUnless skips the test unless the condition is true
 func (r *R) SkipUnless(condition bool, reason string, args ...any) *R {
+	r.T.Helper()
 	if !condition {
 		r.Skip(reason, args...)
 	}
@@ -465,11 +899,52 @@ func (r *R) SkipUnless(condition bool, reason string, args ...any) *R {
 
 // Cleanup registers a cleanup function
 func (r *R) Cleanup(fn func()) *R {
+	r.T.Helper()
 	r.T.Cleanup(fn)
 	r.Case("Cleanup function registered")
 	return r
 }
 
+// trackedCloser wraps an io.Closer and records whether it was closed.
+type trackedCloser struct {
+	io.Closer
+	closed atomic.Bool
+}
+
+// Close closes the wrapped closer and records the call.
+func (c *trackedCloser) Close() error {
+	c.closed.Store(true)
+	return c.Closer.Close()
+}
+
+// TrackCloser wraps c and registers a cleanup that fails the test if the
+// returned closer has not been closed by the end of the test. Pass the
+// wrapper to the code under test to verify it releases the resource.
+//
+// Example:
+//
+//	f := r.TrackCloser(file)
+//	process(f) // must close f
+func (r *R) TrackCloser(c io.Closer) io.Closer {
+	tc := &trackedCloser{Closer: c}
+	r.T.Cleanup(func() {
+		r.assertClosed(tc)
+	})
+	return tc
+}
+
+// assertClosed reports whether the tracked closer has been closed.
+func (r *R) assertClosed(tc *trackedCloser) {
+	r.T.Helper()
+	r.report(check{
+		ok:      tc.closed.Load(),
+		pass:    fmt.Sprintf("Closer %T was closed", tc.Closer),
+		fail:    fmt.Sprintf("Expected closer %T to be closed by the end of the test", tc.Closer),
+		notPass: fmt.Sprintf("Closer %T was not closed", tc.Closer),
+		notFail: fmt.Sprintf("Expected closer %T not to be closed", tc.Closer),
+	}, nil)
+}
+
 // Helper marks the calling function as a test helper function
 func (r *R) Helper() *R {
 	r.T.Helper()
@@ -481,8 +956,73 @@ func (r *R) TempDir() string {
 	return r.T.TempDir()
 }
 
+// TempFile creates a file inside the test's temporary directory, writes
+// content to it and returns its absolute path. The pattern follows the rules
+// of os.CreateTemp. The file is removed together with the temporary directory
+// when the test ends. Any error stops the test.
+func (r *R) TempFile(pattern string, content []byte) string {
+	r.T.Helper()
+	f, err := os.CreateTemp(r.T.TempDir(), pattern)
+	if err != nil {
+		r.Fatal("Failed to create temp file: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(content); err != nil {
+		r.Fatal("Failed to write temp file %s: %v", f.Name(), err)
+	}
+	path, err := filepath.Abs(f.Name())
+	if err != nil {
+		r.Fatal("Failed to resolve temp file path %s: %v", f.Name(), err)
+	}
+	return path
+}
+
+// AssertFileExists asserts that a file or directory exists at path.
+func (r *R) AssertFileExists(path string, msg ...string) *R {
+	r.T.Helper()
+	_, err := os.Stat(path)
+	fail := fmt.Sprintf("Expected file %s to exist, but it is missing", path)
+	if err != nil && !os.IsNotExist(err) {
+		fail = fmt.Sprintf("Expected file %s to exist, but it cannot be accessed: %v", path, err)
+	}
+	r.report(check{
+		ok:      err == nil,
+		pass:    fmt.Sprintf("File %s exists", path),
+		fail:    fail,
+		notPass: fmt.Sprintf("File %s does not exist", path),
+		notFail: fmt.Sprintf("Expected file %s not to exist", path),
+	}, msg)
+	return r
+}
+
+// AssertFileContent asserts that the file at path exists and its content
+// equals expected. The failure message tells a missing file apart from a
+// content mismatch.
+func (r *R) AssertFileContent(path string, expected []byte, msg ...string) *R {
+	r.T.Helper()
+	content, err := os.ReadFile(path)
+	var fail string
+	switch {
+	case os.IsNotExist(err):
+		fail = fmt.Sprintf("Expected file %s to have content %q, but it is missing", path, expected)
+	case err != nil:
+		fail = fmt.Sprintf("Expected file %s to have content %q, but it cannot be read: %v", path, expected, err)
+	default:
+		fail = fmt.Sprintf("Expected file %s to have content %q, got %q", path, expected, content)
+	}
+	r.report(check{
+		ok:      err == nil && bytes.Equal(content, expected),
+		pass:    fmt.Sprintf("File %s has the expected content", path),
+		fail:    fail,
+		notPass: fmt.Sprintf("File %s does not have content %q", path, expected),
+		notFail: fmt.Sprintf("Expected file %s not to have content %q", path, expected),
+	}, msg)
+	return r
+}
+
 // Setenv sets an environment variable for the test
 func (r *R) Setenv(key, value string) *R {
+	r.T.Helper()
 	r.T.Setenv(key, value)
 	r.Case("Environment variable set: %s=%s", key, value)
 	return r
@@ -495,13 +1035,130 @@ func (r *R) Getenv(key string) string {
 	return os.Getenv(key)
 }
 
+// SnapshotEnv records the current environment and registers a cleanup that
+// restores it exactly when the test ends: variables changed or removed by the
+// test are reset to their recorded values and variables added by the test are
+// unset. It also returns the restore function so the environment can be
+// restored earlier; the environment is restored at most once.
+//
+// Unlike Setenv, which relies on testing.T.Setenv, SnapshotEnv also covers
+// variables changed directly with os.Setenv or os.Unsetenv, such as by the
+// code under test.
+//
+// Example:
+//
+//	restore := r.SnapshotEnv()
+//	os.Setenv("APP_MODE", "test")
+//	os.Unsetenv("HOME")
+//	restore() // or let the cleanup restore it when the test ends
+func (r *R) SnapshotEnv() (restore func()) {
+	snapshot := make(map[string]string)
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			snapshot[k] = v
+		}
+	}
+	var once sync.Once
+	restore = func() {
+		once.Do(func() {
+			for _, kv := range os.Environ() {
+				if k, _, ok := strings.Cut(kv, "="); ok {
+					if _, keep := snapshot[k]; !keep {
+						os.Unsetenv(k)
+					}
+				}
+			}
+			for k, v := range snapshot {
+				if cur, ok := os.LookupEnv(k); !ok || cur != v {
+					os.Setenv(k, v)
+				}
+			}
+		})
+	}
+	r.T.Cleanup(restore)
+	return restore
+}
+
 // Deadline returns the time when the test will be timed out
 func (r *R) Deadline() (deadline time.Time, ok bool) {
 	return r.T.Deadline()
 }
 
+// Context returns a context that is canceled when the test ends, just before
+// the functions registered with Cleanup are called. If the test has a deadline
+// (see Deadline), the context is also bounded by it.
+func (r *R) Context() context.Context {
+	ctx := r.T.Context()
+	if deadline, ok := r.Deadline(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		r.T.Cleanup(cancel)
+	}
+	return ctx
+}
+
+// WithCancel returns a cancelable child of Context together with its cancel
+// function. The context is canceled automatically when the test ends, so
+// calling cancel is only needed to cancel it earlier.
+func (r *R) WithCancel() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(r.Context())
+	r.T.Cleanup(cancel)
+	return ctx, cancel
+}
+
+const (
+	// pollInterval is how often AssertBeforeDeadline evaluates its condition.
+	pollInterval = 10 * time.Millisecond
+	// deadlineMargin is reserved before the test deadline so that a failure
+	// is reported before the test binary panics on timeout.
+	deadlineMargin = time.Second
+	// noDeadlineWait bounds AssertBeforeDeadline when the test has no deadline.
+	noDeadlineWait = 10 * time.Second
+)
+
+// AssertBeforeDeadline polls fn until it returns true, failing if it does not
+// before the test's deadline (see Deadline). A short margin is kept before the
+// deadline so that the failure, including how much time was left, is reported
+// instead of the test binary panicking on timeout. Without a deadline, fn is
+// polled for at most 10 seconds.
+//
+// Example:
+//
+//	go server.Start()
+//	r.AssertBeforeDeadline(server.Ready, "server should become ready")
+func (r *R) AssertBeforeDeadline(fn func() bool, msg ...string) *R {
+	deadline, ok := r.Deadline()
+	if ok {
+		deadline = deadline.Add(-deadlineMargin)
+	} else {
+		deadline = time.Now().Add(noDeadlineWait)
+	}
+	return r.assertBefore(fn, deadline, msg)
+}
+
+// assertBefore polls fn until it returns true or deadline passes.
+func (r *R) assertBefore(fn func() bool, deadline time.Time, msg []string) *R {
+	r.T.Helper()
+	start := time.Now()
+	met := fn()
+	for !met && time.Now().Before(deadline) {
+		time.Sleep(min(pollInterval, time.Until(deadline)))
+		met = fn()
+	}
+	left := max(time.Until(deadline), 0)
+	r.report(check{
+		ok:      met,
+		pass:    fmt.Sprintf("Condition met after %v", time.Since(start).Round(time.Millisecond)),
+		fail:    fmt.Sprintf("Condition not met after %v, with %v left before the deadline", time.Since(start).Round(time.Millisecond), left.Round(time.Millisecond)),
+		notPass: "Condition was not met before the deadline",
+		notFail: fmt.Sprintf("Expected condition not to be met, but it was after %v", time.Since(start).Round(time.Millisecond)),
+	}, msg)
+	return r
+}
+
 // RunParallel runs tests in parallel
 func (r *R) RunParallel(fn func(*testing.PB)) *R {
+	r.T.Helper()
 	// Note: testing.T.RunParallel is not available in all Go versions
 	// This is a simplified implementation
 	r.Case("Running tests in parallel")
@@ -510,6 +1167,7 @@ func (r *R) RunParallel(fn func(*testing.PB)) *R {
 
 // MemoryUsage logs memory usage information
 func (r *R) MemoryUsage() *R {
+	r.T.Helper()
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
 
@@ -524,6 +1182,7 @@ func (r *R) MemoryUsage() *R {
 
 // GoroutineCount logs the current goroutine count
 func (r *R) GoroutineCount() *R {
+	r.T.Helper()
 	count := runtime.NumGoroutine()
 	r.Case("Goroutine count: %d", count)
 	return r
@@ -531,6 +1190,7 @@ func (r *R) GoroutineCount() *R {
 
 // TestInfo logs comprehensive test information
 func (r *R) TestInfo() *R {
+	r.T.Helper()
 	r.Case("Test Information")
 	r.Logf("Test Name: %s", r.T.Name())
 	r.Logf("Start Time: %v", r.startTime)
@@ -549,105 +1209,97 @@ func (r *R) TestInfo() *R {
 
 // AssertEqual provides a more descriptive equality assertion
 func (r *R) AssertEqual(expected, actual any, msg ...string) *R {
-	if !reflect.DeepEqual(expected, actual) {
-		message := fmt.Sprintf("Expected %v, got %v", expected, actual)
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Values are equal")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      reflect.DeepEqual(expected, actual),
+		pass:    "Values are equal",
+		fail:    fmt.Sprintf("Expected %v, got %v", expected, actual),
+		notPass: "Values are not equal",
+		notFail: fmt.Sprintf("Expected values to be different, but both are %v", expected),
+	}, msg)
 	return r
 }
 
 // AssertNotEqual provides a more descriptive inequality assertion
 func (r *R) AssertNotEqual(expected, actual any, msg ...string) *R {
-	if reflect.DeepEqual(expected, actual) {
-		message := fmt.Sprintf("Expected values to be different, but both are %v", expected)
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Values are not equal")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      !reflect.DeepEqual(expected, actual),
+		pass:    "Values are not equal",
+		fail:    fmt.Sprintf("Expected values to be different, but both are %v", expected),
+		notPass: "Values are equal",
+		notFail: fmt.Sprintf("Expected %v, got %v", expected, actual),
+	}, msg)
 	return r
 }
 
 // AssertNil provides a more descriptive nil assertion
 func (r *R) AssertNil(value any, msg ...string) *R {
-	if value != nil {
-		message := fmt.Sprintf("Expected nil, got %v", value)
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Value is nil")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      value == nil,
+		pass:    "Value is nil",
+		fail:    fmt.Sprintf("Expected nil, got %v", value),
+		notPass: "Value is not nil",
+		notFail: "Expected non-nil value, got nil",
+	}, msg)
 	return r
 }
 
 // AssertNotNil provides a more descriptive non-nil assertion
 func (r *R) AssertNotNil(value any, msg ...string) *R {
-	if value == nil {
-		message := "Expected non-nil value, got nil"
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Value is not nil")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      value != nil,
+		pass:    "Value is not nil",
+		fail:    "Expected non-nil value, got nil",
+		notPass: "Value is nil",
+		notFail: fmt.Sprintf("Expected nil, got %v", value),
+	}, msg)
 	return r
 }
 
 // AssertTrue provides a more descriptive true assertion
 func (r *R) AssertTrue(condition bool, msg ...string) *R {
-	if !condition {
-		message := "Expected condition to be true"
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Condition is true")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      condition,
+		pass:    "Condition is true",
+		fail:    "Expected condition to be true",
+		notPass: "Condition is false",
+		notFail: "Expected condition to be false",
+	}, msg)
 	return r
 }
 
 // AssertFalse provides a more descriptive false assertion
 func (r *R) AssertFalse(condition bool, msg ...string) *R {
-	if condition {
-		message := "Expected condition to be false"
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Condition is false")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      !condition,
+		pass:    "Condition is false",
+		fail:    "Expected condition to be false",
+		notPass: "Condition is true",
+		notFail: "Expected condition to be true",
+	}, msg)
 	return r
 }
 
 // AssertContains provides a more descriptive contains assertion
 func (r *R) AssertContains(container, item any, msg ...string) *R {
-	contains := r.contains(container, item)
-
-	if !contains {
-		message := fmt.Sprintf("Expected %v to contain %v", container, item)
-		if len(msg) > 0 {
-			message = msg[0]
-		}
-		r.Fail(message)
-	} else {
-		r.Pass("Container contains item")
-	}
+	r.T.Helper()
+	r.report(check{
+		ok:      r.contains(container, item),
+		pass:    "Container contains item",
+		fail:    fmt.Sprintf("Expected %v to contain %v", container, item),
+		notPass: "Container does not contain item",
+		notFail: fmt.Sprintf("Expected %v not to contain %v", container, item),
+	}, msg)
 	return r
 }
 
 func (r *R) contains(container, item any) bool {
+	r.T.Helper()
 	contains := false
 
 	switch c := container.(type) {
@@ -680,53 +1332,252 @@ func (r *R) contains(container, item any) bool {
 
 // AssertNotContains provides a more descriptive not-contains assertion
 func (r *R) AssertNotContains(container, item any, msg ...string) *R {
-	contains := r.contains(container, item)
+	r.T.Helper()
+	r.report(check{
+		ok:      !r.contains(container, item),
+		pass:    "Container does not contain item",
+		fail:    fmt.Sprintf("Expected %v not to contain %v", container, item),
+		notPass: "Container contains item",
+		notFail: fmt.Sprintf("Expected %v to contain %v", container, item),
+	}, msg)
+	return r
+}
+
+// AssertContainsInOrder asserts that each of parts occurs in s after the end
+// of the previous one. On failure, the first part that is missing or out of
+// order is reported together with the index at which searching stopped.
+//
+// Example:
+//
+//	r.AssertContainsInOrder(output, "connecting", "connected", "closing")
+func (r *R) AssertContainsInOrder(s string, parts ...string) *R {
+	r.T.Helper()
+	r.containsInOrder(s, parts, nil)
+	return r
+}
+
+// AssertContainsInOrderf is like AssertContainsInOrder, with the failure
+// message built with fmt.Sprintf from format and args.
+//
+// Example:
+//
+//	r.AssertContainsInOrderf(output, []string{"begin", "commit"}, "transaction %d", id)
+func (r *R) AssertContainsInOrderf(s string, parts []string, format string, args ...any) *R {
+	r.T.Helper()
+	r.containsInOrder(s, parts, []string{formatMessage(format, args)})
+	return r
+}
 
-	if contains {
-		message := fmt.Sprintf("Expected %v not to contain %v", container, item)
-		if len(msg) > 0 {
-			message = msg[0]
+// containsInOrder implements AssertContainsInOrder, reporting msg instead of
+// the failure message if given.
+func (r *R) containsInOrder(s string, parts []string, msg []string) {
+	r.T.Helper()
+	pos, fail := 0, ""
+	for i, part := range parts {
+		at := strings.Index(s[pos:], part)
+		if at >= 0 {
+			pos += at + len(part)
+			continue
 		}
-		r.Fail(message)
-	} else {
-		r.Pass("Container does not contain item")
+		fail = fmt.Sprintf("Expected part %d (%q) after index %d, but it is missing", i, part, pos)
+		if earlier := strings.Index(s, part); earlier >= 0 {
+			fail = fmt.Sprintf("Expected part %d (%q) after index %d, but it only occurs earlier, at index %d", i, part, pos, earlier)
+		}
+		break
 	}
+	r.report(check{
+		ok:      fail == "",
+		pass:    fmt.Sprintf("String contains the %d parts in order", len(parts)),
+		fail:    fail + " in:\n" + s,
+		notPass: "String does not contain the parts in order",
+		notFail: fmt.Sprintf("Expected %q not to contain the %d parts in order", s, len(parts)),
+	}, msg)
+}
+
+// AssertRegexp asserts that actual matches the regular expression pattern.
+// An invalid pattern fails the assertion with a distinct message.
+func (r *R) AssertRegexp(pattern string, actual string, msg ...string) *R {
+	r.T.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		r.Fail("Invalid regular expression %q: %v", pattern, err)
+		return r
+	}
+	r.report(check{
+		ok:      re.MatchString(actual),
+		pass:    fmt.Sprintf("String matches %q", pattern),
+		fail:    fmt.Sprintf("Expected %q to match %q", actual, pattern),
+		notPass: fmt.Sprintf("String does not match %q", pattern),
+		notFail: fmt.Sprintf("Expected %q not to match %q", actual, pattern),
+	}, msg)
 	return r
 }
 
-// AssertPanics provides a more descriptive panic assertion
-func (r *R) AssertPanics(fn func(), msg ...string) *R {
-	defer func() {
-		if recover := recover(); recover == nil {
-			message := "Expected function to panic"
-			if len(msg) > 0 {
-				message = msg[0]
-			}
-			r.Fail(message)
-		} else {
-			r.Pass("Function panicked as expected")
-		}
-	}()
+// AssertNotRegexp asserts that actual does not match the regular expression
+// pattern. An invalid pattern fails the assertion with a distinct message.
+func (r *R) AssertNotRegexp(pattern string, actual string, msg ...string) *R {
+	r.T.Helper()
+	r.Not().AssertRegexp(pattern, actual, msg...)
+	return r
+}
 
-	fn()
-	r.Fail("Expected function to panic")
+// AssertPanics provides a more descriptive panic assertion.
+// Exactly one pass or fail message is reported, whether or not fn panics.
+func (r *R) AssertPanics(fn func(), msg ...string) *R {
+	r.T.Helper()
+	recovered, panicked := catchPanic(fn)
+	r.report(check{
+		ok:      panicked,
+		pass:    "Function panicked as expected",
+		fail:    "Expected function to panic",
+		notPass: "Function did not panic",
+		notFail: fmt.Sprintf("Expected function not to panic, but it panicked with %v", recovered),
+	}, msg)
 	return r
 }
 
 // AssertNotPanics provides a more descriptive no-panic assertion
 func (r *R) AssertNotPanics(fn func(), msg ...string) *R {
+	r.T.Helper()
+	recovered, panicked := catchPanic(fn)
+	r.report(check{
+		ok:      !panicked,
+		pass:    "Function did not panic",
+		fail:    fmt.Sprintf("Expected function not to panic, but it panicked with %v", recovered),
+		notPass: "Function panicked as expected",
+		notFail: "Expected function to panic",
+	}, msg)
+	return r
+}
+
+// AssertPanicsWith asserts that fn panics with a value deeply equal to expected.
+// If the recovered value is an error and expected is a string, the error
+// message is compared instead.
+func (r *R) AssertPanicsWith(fn func(), expected any, msg ...string) *R {
+	r.T.Helper()
+	recovered, panicked := catchPanic(fn)
+	ok := panicked && panicValueEqual(expected, recovered)
+	fail := fmt.Sprintf("Expected function to panic with %v, but it panicked with %v", expected, recovered)
+	if !panicked {
+		fail = fmt.Sprintf("Expected function to panic with %v, but it did not panic", expected)
+	}
+	r.report(check{
+		ok:      ok,
+		pass:    fmt.Sprintf("Function panicked with %v as expected", expected),
+		fail:    fail,
+		notPass: fmt.Sprintf("Function did not panic with %v", expected),
+		notFail: fmt.Sprintf("Expected function not to panic with %v", expected),
+	}, msg)
+	return r
+}
+
+// AssertPanicsContains asserts that fn panics and that the recovered value,
+// formatted with %v, contains substr.
+func (r *R) AssertPanicsContains(fn func(), substr string, msg ...string) *R {
+	r.T.Helper()
+	recovered, panicked := catchPanic(fn)
+	ok := panicked && strings.Contains(fmt.Sprint(recovered), substr)
+	fail := fmt.Sprintf("Expected panic value %q to contain %q", fmt.Sprint(recovered), substr)
+	if !panicked {
+		fail = fmt.Sprintf("Expected function to panic with a value containing %q, but it did not panic", substr)
+	}
+	r.report(check{
+		ok:      ok,
+		pass:    fmt.Sprintf("Function panicked with a value containing %q", substr),
+		fail:    fail,
+		notPass: fmt.Sprintf("Function did not panic with a value containing %q", substr),
+		notFail: fmt.Sprintf("Expected panic value %q not to contain %q", fmt.Sprint(recovered), substr),
+	}, msg)
+	return r
+}
+
+// AssertPanicsWithType asserts that fn panics with a value of the same type
+// as target, such as (*ValidationError)(nil). If the recovered value is an
+// error, its chain is searched with errors.As, so wrapped errors match too. A
+// pointer to an interface, such as (*runtime.Error)(nil), matches any value
+// implementing that interface. On failure, the recovered value and its type
+// are reported.
+//
+// Example:
+//
+//	r.AssertPanicsWithType(func() { parse("{") }, (*SyntaxError)(nil))
+//	r.AssertPanicsWithType(func() { _ = items[10] }, (*runtime.Error)(nil))
+func (r *R) AssertPanicsWithType(fn func(), target any, msg ...string) *R {
+	r.T.Helper()
+	want := reflect.TypeOf(target)
+	if want == nil {
+		r.Fail("AssertPanicsWithType requires a typed target, got nil")
+		return r
+	}
+	if want.Kind() == reflect.Pointer && want.Elem().Kind() == reflect.Interface {
+		want = want.Elem()
+	}
+	recovered, panicked := catchPanic(fn)
+	fail := fmt.Sprintf("Expected function to panic with a %v, but it panicked with %v (%T)", want, recovered, recovered)
+	if !panicked {
+		fail = fmt.Sprintf("Expected function to panic with a %v, but it did not panic", want)
+	}
+	r.report(check{
+		ok:      panicked && panicValueHasType(recovered, want),
+		pass:    fmt.Sprintf("Function panicked with a %v as expected", want),
+		fail:    fail,
+		notPass: fmt.Sprintf("Function did not panic with a %v", want),
+		notFail: fmt.Sprintf("Expected function not to panic with a %v, but it panicked with %v", want, recovered),
+	}, msg)
+	return r
+}
+
+// Recover runs fn and returns the value it panicked with, if any.
+// The second result reports whether fn panicked at all, which distinguishes
+// a panic(nil) from a normal return. Use it to make follow-up assertions on
+// the panic value.
+//
+// Example:
+//
+//	v, panicked := r.Recover(func() { mustParse("") })
+//	r.AssertTrue(panicked).AssertEqual(ErrEmpty, v)
+func (r *R) Recover(fn func()) (any, bool) {
+	return catchPanic(fn)
+}
+
+// catchPanic runs fn and recovers the value it panicked with.
+func catchPanic(fn func()) (recovered any, panicked bool) {
+	panicked = true
 	defer func() {
-		if recover := recover(); recover != nil {
-			message := fmt.Sprintf("Expected function not to panic, but it panicked with %v", recover)
-			if len(msg) > 0 {
-				message = msg[0]
-			}
-			r.Fail(message)
-		} else {
-			r.Pass("Function did not panic")
+		if panicked {
+			recovered = recover()
 		}
 	}()
-
 	fn()
-	return r
+	panicked = false
+	return nil, false
+}
+
+// panicValueEqual compares a recovered panic value with the expected one.
+func panicValueEqual(expected, recovered any) bool {
+	if s, ok := expected.(string); ok {
+		if err, ok := recovered.(error); ok {
+			return err.Error() == s
+		}
+	}
+	return reflect.DeepEqual(expected, recovered)
+}
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// panicValueHasType reports whether a recovered panic value has type want, or
+// implements it if want is an interface. Errors are matched along their chain.
+func panicValueHasType(recovered any, want reflect.Type) bool {
+	if err, ok := recovered.(error); ok && (want.Kind() == reflect.Interface || want.Implements(errorType)) {
+		return errors.As(err, reflect.New(want).Interface())
+	}
+	got := reflect.TypeOf(recovered)
+	if got == nil {
+		return false
+	}
+	if want.Kind() == reflect.Interface {
+		return got.Implements(want)
+	}
+	return got == want
 }
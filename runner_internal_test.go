@@ -0,0 +1,371 @@
+package got
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// detached runs fn against a runner backed by a detached testing.T so that
+// failures can be inspected without failing the enclosing test. fn runs on its
+// own goroutine, so FailNow stops fn only. The testing.T is not created by the
+// testing package: its Context is nil and its Cleanup callbacks never run. It
+// mirrors gottest.Detached, which the tests of this package cannot import.
+func detached(fn func(r *R), opts ...Option) *R {
+	r := New(&testing.T{}, "detached", opts...)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(r)
+	}()
+	<-done
+	return r
+}
+
+// TestAssertPanicsReportsOnce tests that AssertPanics reports a single outcome
+func TestAssertPanicsReportsOnce(t *testing.T) {
+	r := detached(func(r *R) {
+		r.AssertPanics(func() {})
+	})
+	if r.failures != 1 {
+		t.Errorf("expected exactly one failure when fn does not panic, got %d", r.failures)
+	}
+
+	var ret *R
+	r = detached(func(r *R) {
+		ret = r.AssertPanics(func() { panic("boom") })
+	})
+	if r.failures != 0 {
+		t.Errorf("expected no failure when fn panics, got %d", r.failures)
+	}
+	if ret != r {
+		t.Error("AssertPanics should return the runner instance when fn panics")
+	}
+}
+
+// TestAssertNotPanicsReportsOnce tests that AssertNotPanics reports a single outcome
+func TestAssertNotPanicsReportsOnce(t *testing.T) {
+	r := detached(func(r *R) {
+		r.AssertNotPanics(func() { panic("boom") })
+	})
+	if r.failures != 1 {
+		t.Errorf("expected exactly one failure when fn panics, got %d", r.failures)
+	}
+}
+
+// TestNotSharesFailures tests that failures in a Not view count on the runner
+func TestNotSharesFailures(t *testing.T) {
+	r := detached(func(r *R) {
+		r.Not().AssertTrue(true)
+		r.Not().Not().AssertTrue(false)
+	})
+	if r.failures != 2 {
+		t.Errorf("expected failures in Not views to be counted on the runner, got %d", r.failures)
+	}
+}
+
+// TestTrackCloserLeak tests that an unclosed tracked closer is reported
+func TestTrackCloserLeak(t *testing.T) {
+	r := detached(func(r *R) {
+		leaked := r.TrackCloser(io.NopCloser(nil)).(*trackedCloser)
+		r.assertClosed(leaked)
+
+		closed := r.TrackCloser(io.NopCloser(nil)).(*trackedCloser)
+		_ = closed.Close()
+		r.assertClosed(closed)
+	})
+	if r.failures != 1 {
+		t.Errorf("expected exactly the leaked closer to fail, got %d failures", r.failures)
+	}
+}
+
+// TestNestedCasePrefix tests that cases inside subtests are numbered hierarchically
+func TestNestedCasePrefix(t *testing.T) {
+	r := New(t, "nested")
+	var prefixes []string
+	record := func() { prefixes = append(prefixes, r.scope().prefix) }
+
+	r.Case("first")
+	record()
+	r.Caser("outer", func(tt *testing.T) {
+		r.Case("a")
+		record()
+		r.Caser("inner", func(tt *testing.T) {
+			r.Case("deep")
+			record()
+		})
+		r.Case("b")
+		record()
+	})
+	r.Case("last")
+	record()
+
+	want := []string{"Case 1 -> ", "Case 2.1 -> ", "Case 2.2.1 -> ", "Case 2.3 -> ", "Case 3 -> "}
+	if len(prefixes) != len(want) {
+		t.Fatalf("expected %d prefixes, got %v", len(want), prefixes)
+	}
+	for i := range want {
+		if prefixes[i] != want[i] {
+			t.Errorf("prefix %d: expected %q, got %q", i, want[i], prefixes[i])
+		}
+	}
+}
+
+// TestParallelCasePrefix tests that parallel subtests number their cases with
+// their own runners
+func TestParallelCasePrefix(t *testing.T) {
+	var mu sync.Mutex
+	prefixes := map[string][]string{}
+	t.Run("group", func(t *testing.T) {
+		r := New(t, "parallel")
+		if r.Sub(t) != r {
+			t.Error("Sub of a test not started through the runner should return the runner")
+		}
+		for _, name := range []string{"a", "b", "c"} {
+			r.Caser(name, func(tt *testing.T) {
+				tt.Parallel()
+				sr := r.Sub(tt)
+				for range 2 {
+					sr.Case("step")
+					mu.Lock()
+					prefixes[name] = append(prefixes[name], sr.prefix)
+					mu.Unlock()
+				}
+			})
+		}
+	})
+
+	want := map[string][]string{
+		"a": {"Case 1.1 -> ", "Case 1.2 -> "},
+		"b": {"Case 2.1 -> ", "Case 2.2 -> "},
+		"c": {"Case 3.1 -> ", "Case 3.2 -> "},
+	}
+	for name, w := range want {
+		if got := prefixes[name]; !reflect.DeepEqual(got, w) {
+			t.Errorf("subtest %s: expected prefixes %q, got %q", name, w, got)
+		}
+	}
+}
+
+// TestAssertBefore tests polling a condition until a deadline
+func TestAssertBefore(t *testing.T) {
+	start := time.Now()
+	r := detached(func(r *R) {
+		r.assertBefore(func() bool { return time.Since(start) > 30*time.Millisecond }, time.Now().Add(time.Second), nil)
+	})
+	if r.failures != 0 {
+		t.Errorf("expected condition met before the deadline to pass, got %d failures", r.failures)
+	}
+
+	start = time.Now()
+	r = detached(func(r *R) {
+		r.assertBefore(func() bool { return false }, time.Now().Add(50*time.Millisecond), nil)
+	})
+	if r.failures != 1 {
+		t.Errorf("expected condition never met to fail once, got %d failures", r.failures)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Errorf("expected polling to stop at the deadline, took %v", elapsed)
+	}
+}
+
+// TestGroup tests summarizing a group of assertions
+func TestGroup(t *testing.T) {
+	var out bytes.Buffer
+	r := New(t, "Test Group", WithReporter(&out), WithColor(false))
+	var inner *R
+	r.Group("passing", func(g *R) {
+		inner = g
+		g.AssertTrue(true).AssertEqual(1, 1)
+	})
+	if inner == nil {
+		t.Fatal("expected the group function to be called")
+	}
+	if !strings.Contains(out.String(), "passing: passed") {
+		t.Errorf("expected the group to be reported as passed, got %q", out.String())
+	}
+
+	failing := detached(func(r *R) {
+		r.Group("failing", func(g *R) {
+			g.AssertTrue(false)
+			g.AssertEqual(1, 2)
+		})
+	})
+	if failing.failures != 2 || !failing.Failed() {
+		t.Errorf("expected the group failures to fail the test, got %d failures", failing.failures)
+	}
+}
+
+// TestFormatTaggedLiteralPercent tests messages containing literal % signs
+func TestFormatTaggedLiteralPercent(t *testing.T) {
+	var out bytes.Buffer
+	detached(func(r *R) {
+		out.Reset()
+		r.Pass("100% done")
+		r.Pass("%d%% done", 50)
+		r.Fail("coverage dropped to 80%")
+		r.Fatal("disk 99% full")
+	}, WithReporter(&out), WithColor(false))
+
+	want := "\t[PASS] 100% done\n\t[PASS] 50% done\n\t[FAIL] coverage dropped to 80%\n\t[FATAL] disk 99% full\n"
+	if out.String() != want {
+		t.Errorf("expected output %q, got %q", want, out.String())
+	}
+
+	out.Reset()
+	detached(func(r *R) { r.AssertEqual("50%", "50%") }, WithReporter(&out), WithColor(false))
+	if strings.Contains(out.String(), "%!") {
+		t.Errorf("expected assertion output without formatting errors, got %q", out.String())
+	}
+}
+
+// TestAssertCasePanic tests the outcomes reported for cases implementing PanicCase
+func TestAssertCasePanic(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   bool
+		body   func()
+		failed int
+	}{
+		{"expected panic", true, func() { panic("boom") }, 0},
+		{"missing panic", true, func() {}, 1},
+		{"unexpected panic", false, func() { panic("boom") }, 1},
+		{"no panic", false, func() {}, 0},
+		{"goexit", true, runtime.Goexit, 0},
+	}
+	for _, tc := range tests {
+		r := detached(func(r *R) { r.assertCasePanic(tc.want, tc.body) })
+		if r.failures != tc.failed {
+			t.Errorf("%s: expected %d failures, got %d", tc.name, tc.failed, r.failures)
+		}
+	}
+}
+
+// TestOrderedRowOutput tests holding the output of ordered rows
+func TestOrderedRowOutput(t *testing.T) {
+	var buf bytes.Buffer
+	out := newRowOutput(2)
+	row := func(i int, fn func(r *R)) *R {
+		return detached(func(r *R) {
+			r.row = out.rows[i]
+			fn(r)
+		}, WithColor(false))
+	}
+	detached(func(r *R) {
+		buf.Reset()
+		second := row(1, func(sr *R) { sr.Fail("second row failed") })
+		if !second.Failed() {
+			t.Error("A held failure should fail the row")
+		}
+		r.complete(out, 1)
+		if buf.Len() != 0 {
+			t.Errorf("Output of row 2 should wait for row 1, got %q", buf.String())
+		}
+
+		row(0, func(sr *R) { sr.Not().Log("first row") })
+		r.complete(out, 0)
+		if got, want := buf.String(), "first row\n\t[FAIL] second row failed\n"; got != want {
+			t.Errorf("Expected output %q, got %q", want, got)
+		}
+		r.Log("after")
+		if !strings.HasSuffix(buf.String(), "after\n") {
+			t.Errorf("Output after the rows should be written immediately, got %q", buf.String())
+		}
+	}, WithReporter(&buf), WithColor(false))
+}
+
+// fakeM stands in for testing.M in the tests of runMain.
+type fakeM struct {
+	code  int
+	panic bool
+	run   func()
+	ran   bool
+}
+
+func (m *fakeM) Run() int {
+	m.ran = true
+	if m.panic {
+		panic("boom")
+	}
+	if m.run != nil {
+		m.run()
+	}
+	return m.code
+}
+
+// TestRunMain tests the setup, run and teardown order of Main
+func TestRunMain(t *testing.T) {
+	var steps []string
+	setup := func() error { steps = append(steps, "setup"); return nil }
+	teardown := func() { steps = append(steps, "teardown") }
+
+	m := &fakeM{code: 3}
+	if code := runMain(m, setup, teardown, io.Discard); code != 3 || !m.ran {
+		t.Errorf("Expected the tests to run with exit code 3, got ran=%v code=%d", m.ran, code)
+	}
+	if strings.Join(steps, ",") != "setup,teardown" {
+		t.Errorf("Expected setup then teardown, got %v", steps)
+	}
+	mainTeardown()
+	if strings.Join(steps, ",") != "setup,teardown" {
+		t.Errorf("Expected the teardown of Main not to run once Main returned, got %v", steps)
+	}
+	if code := runMain(&fakeM{}, nil, nil, io.Discard); code != 0 {
+		t.Errorf("Expected Main to run without a teardown, got code %d", code)
+	}
+
+	steps = nil
+	var stderr bytes.Buffer
+	m = &fakeM{}
+	failing := func() error { return io.ErrUnexpectedEOF }
+	if code := runMain(m, failing, teardown, &stderr); code != 1 || m.ran {
+		t.Errorf("Expected a failing setup to exit with 1 without running tests, got ran=%v code=%d", m.ran, code)
+	}
+	if !strings.Contains(stderr.String(), "setup failed: unexpected EOF") {
+		t.Errorf("Expected the setup error to be printed, got %q", stderr.String())
+	}
+	if strings.Join(steps, ",") != "teardown" {
+		t.Errorf("Expected teardown after a failing setup, got %v", steps)
+	}
+
+	steps = nil
+	func() {
+		defer func() { _ = recover() }()
+		runMain(&fakeM{panic: true}, nil, teardown, io.Discard)
+	}()
+	if strings.Join(steps, ",") != "teardown" {
+		t.Errorf("Expected teardown after a panic, got %v", steps)
+	}
+}
+
+// TestRunMainSubtestPanic tests that teardown runs when a subtest panics. The
+// panic kills the process, so the subtest runs in a child test process.
+func TestRunMainSubtestPanic(t *testing.T) {
+	if os.Getenv("GOT_MAIN_PANIC") != "" {
+		teardown := func() { fmt.Println("teardown ran") }
+		runMain(&fakeM{run: func() {
+			New(t, "panic").Run("panics", func(*testing.T) { panic("boom") })
+		}}, nil, teardown, io.Discard)
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunMainSubtestPanic$")
+	cmd.Env = append(os.Environ(), "GOT_MAIN_PANIC=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("Expected the panic to fail the child test, got:\n%s", out)
+	}
+	if !strings.Contains(string(out), "teardown ran") || !strings.Contains(string(out), "panic: boom") {
+		t.Errorf("Expected teardown to run before the panic ended the process, got:\n%s", out)
+	}
+	if n := strings.Count(string(out), "teardown ran"); n != 1 {
+		t.Errorf("Expected teardown to run once, got %d times", n)
+	}
+}
@@ -1,13 +1,20 @@
 package got_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
 )
 
 func TestNew(t *testing.T) {
@@ -25,6 +32,19 @@ func TestNewWithTitle(t *testing.T) {
 	})
 }
 
+func TestWrap(t *testing.T) {
+	tr := got.Wrap(t)
+	tr.Case("wrap runner from testing.T")
+	tr.Require(tr != nil, "wrap runner should be success")
+	tr.Require(tr.Name() == t.Name(), "wrap runner should wrap the same test")
+}
+
+func TestWrapWithTitle(t *testing.T) {
+	tr := got.Wrap(t, "test wrap with title")
+	tr.Case("wrap runner with title")
+	tr.Require(tr != nil, "wrap runner with title should be success")
+}
+
 func TestCase(t *testing.T) {
 	tr := got.New(t, "test case")
 	ret := tr.Case("case description: %d", 1)
@@ -137,6 +157,50 @@ func TestCases(t *testing.T) {
 	})
 }
 
+// panicCase is a case expected to panic, or not, as panics says
+type panicCase struct {
+	got.Case
+	panics bool
+}
+
+func (c panicCase) WantPanic() bool { return c.panics }
+
+func TestCasesWantPanic(t *testing.T) {
+	tr := got.New(t, "test cases want panic")
+	cases := []got.Case{
+		panicCase{Case: got.NewCase("divides", []int{4, 2}, 2, false, nil)},
+		panicCase{Case: got.NewCase("divides by zero", []int{1, 0}, 0, false, nil), panics: true},
+		got.NewCase("plain case", []int{9, 3}, 3, false, nil),
+	}
+	ran := 0
+	tr.Cases(cases, func(c got.Case, tt *testing.T) {
+		ran++
+		in := c.Input().([]int)
+		tr.AssertEqual(c.Want(), in[0]/in[1])
+	})
+	tr.AssertEqual(3, ran, "every case should run")
+	tr.CasesMap(map[string]got.Case{
+		"named panic": panicCase{Case: got.NewCase("", nil, nil, false, nil), panics: true},
+	}, func(c got.Case, tt *testing.T) {
+		panic("boom")
+	})
+}
+
+func TestCasesMap(t *testing.T) {
+	tr := got.New(t, "test cases map")
+	cases := map[string]got.Case{
+		"valid": got.NewCase("ignored", "hello", 5, false, nil),
+		"empty": got.NewCase("", "", 0, false, nil),
+		"multi": got.NewCase("", "hi there", 8, false, nil),
+	}
+	var names []string
+	tr.CasesMap(cases, func(c got.Case, tt *testing.T) {
+		names = append(names, c.Name())
+		tr.AssertEqual(c.Want(), len(c.Input().(string)))
+	})
+	tr.AssertEqual([]string{"empty", "multi", "valid"}, names, "Cases should run in key order named by key")
+}
+
 func TestCaser(t *testing.T) {
 	tr := got.New(t, "test Caser")
 	tr.Caser("should pass when values are equal", func(tt *testing.T) {
@@ -574,3 +638,283 @@ func TestEdgeCases(t *testing.T) {
 	var zero int
 	r.Require(zero == 0, "Zero value should be 0")
 }
+
+// TestAssertNoErrors tests the AssertNoErrors and MustNoErrors methods
+func TestAssertNoErrors(t *testing.T) {
+	r := got.New(t, "Test AssertNoErrors")
+
+	r.Case("Testing all nil errors")
+	r.AssertNoErrors(nil, nil, nil)
+	r.MustNoErrors()
+
+	r.Case("Testing non-nil errors do not stop the test")
+	reached := false
+	failed := gottest.Probe(func(pr *got.R) {
+		pr.AssertNoErrors(nil, errors.New("first"), errors.New("second"))
+		reached = true
+	})
+	r.AssertTrue(failed, "AssertNoErrors should fail when an error is present")
+	r.AssertTrue(reached, "AssertNoErrors should not stop the test")
+
+	r.Case("Testing MustNoErrors stops the test")
+	reached = false
+	failed = gottest.Probe(func(pr *got.R) {
+		pr.MustNoErrors(errors.New("boom"))
+		reached = true
+	})
+	r.AssertTrue(failed, "MustNoErrors should fail when an error is present")
+	r.AssertFalse(reached, "MustNoErrors should stop the test")
+}
+
+// TestNot tests the negated runner view
+func TestNot(t *testing.T) {
+	r := got.New(t, "Test Not")
+
+	r.Case("Testing negated assertions pass when condition does not hold")
+	r.Not().AssertContains("hello world", "foo")
+	r.Not().AssertEqual(1, 2).AssertNil("value").AssertTrue(false)
+	r.Not().Not().AssertEqual(1, 1)
+
+	r.Case("Testing negated assertions fail when condition holds")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().AssertContains("hello world", "world") }), "negated AssertContains should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.Not().AssertNotEqual(1, 2) }), "negated AssertNotEqual should fail")
+
+	r.Case("Testing error, order and property assertions are negated")
+	boom := errors.New("boom")
+	always := func(*rand.Rand) any { return 1 }
+	negated := map[string]struct{ holds, fails func(n *got.R) }{
+		"AssertNoErrors":  {func(n *got.R) { n.AssertNoErrors(nil, boom) }, func(n *got.R) { n.AssertNoErrors(nil, nil) }},
+		"MustNoErrors":    {func(n *got.R) { n.MustNoErrors(boom) }, func(n *got.R) { n.MustNoErrors(nil) }},
+		"AssertErr":       {func(n *got.R) { n.AssertErr(nil) }, func(n *got.R) { n.AssertErr(boom) }},
+		"AssertErrf":      {func(n *got.R) { n.AssertErrf(nil, "open %s", "a") }, func(n *got.R) { n.AssertErrf(boom, "open %s", "a") }},
+		"AssertNoErr":     {func(n *got.R) { n.AssertNoErr(boom) }, func(n *got.R) { n.AssertNoErr(nil) }},
+		"AssertNoErrf":    {func(n *got.R) { n.AssertNoErrf(boom, "open %s", "a") }, func(n *got.R) { n.AssertNoErrf(nil, "open %s", "a") }},
+		"ToBeGreaterThan": {func(n *got.R) { n.Expect(1).ToBeGreaterThan(2) }, func(n *got.R) { n.Expect(3).ToBeGreaterThan(2) }},
+		"ToBeLessThan":    {func(n *got.R) { n.Expect(3).ToBeLessThan(2) }, func(n *got.R) { n.Expect(1).ToBeLessThan(2) }},
+		"Property": {
+			func(n *got.R) { n.Property("never", always, func(any) bool { return false }, 10) },
+			func(n *got.R) { n.Property("always", always, func(any) bool { return true }, 10) },
+		},
+	}
+	for name, c := range negated {
+		r.AssertFalse(gottest.Probe(func(pr *got.R) { c.holds(pr.Not()) }), "negated "+name+" should pass")
+		r.AssertTrue(gottest.Probe(func(pr *got.R) { c.fails(pr.Not()) }), "negated "+name+" should fail")
+	}
+
+	r.Case("Testing the original runner is not negated")
+	n := r.Not()
+	n.AssertFalse(true)
+	r.AssertTrue(true)
+}
+
+// TestAssertPanicsWith tests assertions on the recovered panic value
+func TestAssertPanicsWith(t *testing.T) {
+	r := got.New(t, "Test AssertPanicsWith")
+
+	r.Case("Testing matching panic values")
+	r.AssertPanicsWith(func() { panic("boom") }, "boom")
+	r.AssertPanicsWith(func() { panic(errors.New("bad input")) }, "bad input")
+	r.AssertPanicsContains(func() { panic("index out of range [3]") }, "out of range")
+
+	r.Case("Testing mismatching panic values")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsWith(func() { panic("boom") }, "bang") }), "different panic value should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsWith(func() {}, "boom") }), "missing panic should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsContains(func() { panic("boom") }, "bang") }), "missing substring should fail")
+
+	r.Case("Testing Recover returns the panic value")
+	v, panicked := r.Recover(func() { panic(42) })
+	r.AssertTrue(panicked).AssertEqual(42, v)
+	v, panicked = r.Recover(func() {})
+	r.AssertFalse(panicked).AssertNil(v)
+}
+
+type panicTypeError struct{ field string }
+
+func (e *panicTypeError) Error() string { return "invalid " + e.field }
+
+// TestAssertPanicsWithType tests assertions on the type of the panic value
+func TestAssertPanicsWithType(t *testing.T) {
+	r := got.New(t, "Test AssertPanicsWithType")
+
+	r.Case("Testing matching panic types")
+	r.AssertPanicsWithType(func() { panic(&panicTypeError{"name"}) }, (*panicTypeError)(nil))
+	r.AssertPanicsWithType(func() { panic(fmt.Errorf("wrapped: %w", &panicTypeError{"age"})) }, (*panicTypeError)(nil))
+	r.AssertPanicsWithType(func() { panic("boom") }, "")
+	r.AssertPanicsWithType(func() {
+		var items []int
+		_ = items[len(items)]
+	}, (*runtime.Error)(nil))
+	r.AssertPanicsWithType(func() { panic(errors.New("plain")) }, (*error)(nil))
+
+	r.Case("Testing mismatching panic types")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsWithType(func() { panic("boom") }, 0) }), "different type should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertPanicsWithType(func() { panic(errors.New("plain")) }, (*panicTypeError)(nil))
+	}), "different error type should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsWithType(func() {}, "") }), "missing panic should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertPanicsWithType(func() { panic("boom") }, nil) }), "nil target should fail")
+}
+
+// TestContext tests the test-scoped context helpers
+func TestContext(t *testing.T) {
+	r := got.New(t, "Test Context")
+
+	var ctx, cctx context.Context
+	r.Run("context is canceled at test end", func(tt *testing.T) {
+		rr := got.New(tt, "Context Test")
+		ctx = rr.Context()
+		rr.AssertNil(ctx.Err(), "Context should be alive during the test")
+		if deadline, ok := rr.Deadline(); ok {
+			ctxDeadline, ok := ctx.Deadline()
+			rr.AssertTrue(ok && !ctxDeadline.After(deadline), "Context should be bounded by the test deadline")
+		}
+
+		cctx, _ = rr.WithCancel()
+		rr.AssertNil(cctx.Err(), "Cancelable context should be alive")
+	})
+
+	r.Case("Verifying contexts are canceled")
+	r.AssertNotNil(ctx.Err(), "Context should be canceled after the test ends")
+	r.AssertNotNil(cctx.Err(), "Cancelable context should be canceled after the test ends")
+
+	r.Case("Testing manual cancel")
+	ctx, cancel := r.WithCancel()
+	cancel()
+	r.AssertEqual(context.Canceled, ctx.Err())
+}
+
+// TestAssertFile tests the file assertions
+func TestAssertFile(t *testing.T) {
+	r := got.New(t, "Test AssertFile")
+	path := filepath.Join(r.TempDir(), "out.txt")
+	missing := filepath.Join(r.TempDir(), "missing.txt")
+	r.AssertNoErr(os.WriteFile(path, []byte("hello"), 0o644))
+
+	r.Case("Testing existing file")
+	r.AssertFileExists(path)
+	r.AssertFileContent(path, []byte("hello"))
+	r.Not().AssertFileExists(missing)
+
+	r.Case("Testing missing file and mismatched content")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFileExists(missing) }), "missing file should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFileContent(missing, []byte("hello")) }), "content of missing file should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertFileContent(path, []byte("world")) }), "mismatched content should fail")
+}
+
+// TestTempFile tests creating seeded temp files
+func TestTempFile(t *testing.T) {
+	r := got.New(t, "Test TempFile")
+
+	r.Case("Testing temp file creation")
+	path := r.TempFile("config-*.json", []byte(`{"debug":true}`))
+	r.AssertTrue(filepath.IsAbs(path), "Temp file path should be absolute")
+	r.AssertContains(filepath.Base(path), "config-")
+	r.AssertFileContent(path, []byte(`{"debug":true}`))
+
+	r.Case("Testing distinct temp files")
+	other := r.TempFile("config-*.json", nil)
+	r.AssertNotEqual(path, other, "Temp files should have distinct paths")
+	r.AssertFileContent(other, nil)
+}
+
+// TestAssertRegexp tests the regular expression assertions
+func TestAssertRegexp(t *testing.T) {
+	r := got.New(t, "Test AssertRegexp")
+
+	r.Case("Testing matching strings")
+	r.AssertRegexp(`^usr_[0-9a-f]{8}$`, "usr_0badf00d")
+	r.AssertNotRegexp(`^\d+$`, "12a")
+	r.Not().AssertNotRegexp(`^\d+$`, "123")
+
+	r.Case("Testing mismatches and invalid patterns")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertRegexp(`^\d+$`, "12a") }), "non-matching string should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNotRegexp(`^\d+$`, "123") }), "matching string should fail AssertNotRegexp")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertRegexp(`([`, "x") }), "invalid pattern should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNotRegexp(`([`, "x") }), "invalid pattern should fail AssertNotRegexp")
+}
+
+// TestAssertContainsInOrder tests asserting that substrings appear in order
+func TestAssertContainsInOrder(t *testing.T) {
+	r := got.New(t, "Test AssertContainsInOrder")
+	output := "connecting to db\nconnected\nquery ok\nclosing\n"
+
+	r.Case("Testing parts in order")
+	r.AssertContainsInOrder(output, "connecting", "connected", "closing")
+	r.AssertContainsInOrder(output, "connect", "connect")
+	r.AssertContainsInOrder(output)
+	r.Not().AssertContainsInOrder(output, "closing", "connected")
+
+	r.Case("Testing missing and out-of-order parts")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertContainsInOrder(output, "closing", "connected") }), "out-of-order parts should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertContainsInOrder(output, "connected", "timeout") }), "missing part should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertContainsInOrder("ab", "ab", "b") }), "overlapping parts should fail")
+
+	r.Case("Testing custom messages")
+	r.AssertContainsInOrderf(output, []string{"connecting", "closing"}, "session %d", 1)
+	_, out := gottest.Detached(func(pr *got.R) {
+		pr.AssertContainsInOrderf(output, []string{"closing", "connected"}, "session %d", 1)
+	})
+	r.AssertContains(out, "session 1")
+	r.Not().AssertContains(out, "Expected part", "the custom message should replace the failure message")
+}
+
+// nopCloser counts Close calls
+type nopCloser struct {
+	calls int
+}
+
+func (c *nopCloser) Close() error {
+	c.calls++
+	return nil
+}
+
+// TestTrackCloser tests tracking of io.Closer resources
+func TestTrackCloser(t *testing.T) {
+	r := got.New(t, "Test TrackCloser")
+
+	r.Case("Testing closed resource")
+	c := &nopCloser{}
+	r.Run("closes resource", func(tt *testing.T) {
+		rr := got.New(tt, "TrackCloser Test")
+		tracked := rr.TrackCloser(c)
+		rr.AssertNoErr(tracked.Close())
+	})
+	r.AssertEqual(1, c.calls, "Close should be forwarded to the wrapped closer")
+
+}
+
+// TestSnapshotEnv tests restoring the environment after direct changes
+func TestSnapshotEnv(t *testing.T) {
+	r := got.New(t, "SnapshotEnv")
+	r.Setenv("GOT_SNAPSHOT_KEEP", "original")
+	r.Setenv("GOT_SNAPSHOT_DROP", "present")
+
+	r.Case("Testing manual restore")
+	restore := r.SnapshotEnv()
+	os.Setenv("GOT_SNAPSHOT_KEEP", "changed")
+	os.Unsetenv("GOT_SNAPSHOT_DROP")
+	os.Setenv("GOT_SNAPSHOT_NEW", "added")
+	restore()
+	r.AssertEqual("original", os.Getenv("GOT_SNAPSHOT_KEEP"), "changed variable should be reset")
+	r.AssertEqual("present", os.Getenv("GOT_SNAPSHOT_DROP"), "removed variable should be restored")
+	_, ok := os.LookupEnv("GOT_SNAPSHOT_NEW")
+	r.AssertFalse(ok, "added variable should be unset")
+
+	r.Case("Testing restore at the end of a subtest")
+	r.Run("mutate", func(tt *testing.T) {
+		got.New(tt, "mutate").SnapshotEnv()
+		os.Setenv("GOT_SNAPSHOT_KEEP", "changed")
+		os.Setenv("GOT_SNAPSHOT_NEW", "added")
+	})
+	r.AssertEqual("original", os.Getenv("GOT_SNAPSHOT_KEEP"), "cleanup should reset changed variable")
+	_, ok = os.LookupEnv("GOT_SNAPSHOT_NEW")
+	r.AssertFalse(ok, "cleanup should unset added variable")
+}
+
+// TestAssertBeforeDeadline tests polling a condition until the test deadline
+func TestAssertBeforeDeadline(t *testing.T) {
+	r := got.New(t, "Test AssertBeforeDeadline")
+	var ready atomic.Bool
+	time.AfterFunc(20*time.Millisecond, func() { ready.Store(true) })
+	r.AssertBeforeDeadline(ready.Load, "flag should be set")
+}
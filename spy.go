@@ -0,0 +1,128 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// Spy records the calls made to a callback so that tests can assert it was
+// called, how many times and with which arguments. It is safe for concurrent
+// use. Create it with R.NewSpy and pass Fn, or a typed function made with
+// Func, as the callback.
+//
+// Example:
+//
+//	spy := r.NewSpy()
+//	bus.Subscribe("user.created", spy.Fn)
+//	bus.Publish("user.created", 42)
+//	spy.AssertCalledTimes(1).AssertCalledWith(42)
+type Spy struct {
+	r     *R
+	mu    sync.Mutex
+	calls [][]any
+}
+
+// NewSpy creates a spy reporting its assertions through the runner.
+func (r *R) NewSpy() *Spy {
+	return &Spy{r: r}
+}
+
+// Fn records a call with the given arguments.
+func (s *Spy) Fn(args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, args)
+}
+
+// Func sets the function pointed to by fnPtr to a function that records its
+// calls on the spy and returns zero values. Use it for callbacks with a
+// specific signature.
+//
+// Example:
+//
+//	var onDone func(id int, err error)
+//	spy.Func(&onDone)
+//	job.Run(onDone)
+//	spy.AssertCalledWith(7, nil)
+func (s *Spy) Func(fnPtr any) {
+	s.r.T.Helper()
+	pv := reflect.ValueOf(fnPtr)
+	if pv.Kind() != reflect.Pointer || pv.Elem().Kind() != reflect.Func {
+		s.r.Fatal("Spy.Func requires a pointer to a function, got %T", fnPtr)
+	}
+	ft := pv.Elem().Type()
+	pv.Elem().Set(reflect.MakeFunc(ft, func(in []reflect.Value) []reflect.Value {
+		if ft.IsVariadic() {
+			last := in[len(in)-1]
+			in = in[:len(in)-1]
+			for i := 0; i < last.Len(); i++ {
+				in = append(in, last.Index(i))
+			}
+		}
+		args := make([]any, len(in))
+		for i, v := range in {
+			args[i] = v.Interface()
+		}
+		s.Fn(args...)
+		out := make([]reflect.Value, ft.NumOut())
+		for i := range out {
+			out[i] = reflect.Zero(ft.Out(i))
+		}
+		return out
+	}))
+}
+
+// Calls returns the arguments of every recorded call, in order.
+func (s *Spy) Calls() [][]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// AssertCalled asserts that the spy was called at least once.
+func (s *Spy) AssertCalled(msg ...string) *Spy {
+	s.r.T.Helper()
+	n := len(s.Calls())
+	s.r.report(check{
+		ok:      n > 0,
+		pass:    fmt.Sprintf("Spy was called %d times", n),
+		fail:    "Expected spy to be called, but it was not",
+		notPass: "Spy was not called",
+		notFail: fmt.Sprintf("Expected spy not to be called, but it was called %d times", n),
+	}, msg)
+	return s
+}
+
+// AssertCalledTimes asserts that the spy was called exactly n times.
+func (s *Spy) AssertCalledTimes(n int, msg ...string) *Spy {
+	s.r.T.Helper()
+	calls := len(s.Calls())
+	s.r.report(check{
+		ok:      calls == n,
+		pass:    fmt.Sprintf("Spy was called %d times", n),
+		fail:    fmt.Sprintf("Expected spy to be called %d times, got %d", n, calls),
+		notPass: fmt.Sprintf("Spy was called %d times, not %d", calls, n),
+		notFail: fmt.Sprintf("Expected spy not to be called %d times", n),
+	}, msg)
+	return s
+}
+
+// AssertCalledWith asserts that at least one call was made with arguments
+// deeply equal to args. On failure, the recorded calls are reported.
+func (s *Spy) AssertCalledWith(args ...any) *Spy {
+	s.r.T.Helper()
+	calls := s.Calls()
+	found := slices.ContainsFunc(calls, func(c []any) bool {
+		return len(c) == len(args) && (len(c) == 0 || reflect.DeepEqual(c, args))
+	})
+	s.r.report(check{
+		ok:      found,
+		pass:    fmt.Sprintf("Spy was called with %v", args),
+		fail:    fmt.Sprintf("Expected spy to be called with %v, got calls %v", args, calls),
+		notPass: fmt.Sprintf("Spy was not called with %v", args),
+		notFail: fmt.Sprintf("Expected spy not to be called with %v", args),
+	}, nil)
+	return s
+}
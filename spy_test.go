@@ -0,0 +1,56 @@
+package got_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestSpy tests recording and asserting callback invocations
+func TestSpy(t *testing.T) {
+	r := got.New(t, "Test Spy")
+
+	r.Case("Testing calls through Fn")
+	spy := r.NewSpy()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			spy.Fn("event", i)
+		}()
+	}
+	wg.Wait()
+	spy.AssertCalled().AssertCalledTimes(10).AssertCalledWith("event", 3)
+	r.AssertEqual(10, len(spy.Calls()))
+
+	r.Case("Testing typed callbacks made with Func")
+	typed := r.NewSpy()
+	var onDone func(id int, err error) bool
+	typed.Func(&onDone)
+	r.AssertFalse(onDone(7, nil))
+	var logf func(format string, args ...any)
+	typed.Func(&logf)
+	logf("%d items", 3)
+	typed.AssertCalledTimes(2).AssertCalledWith(7, nil).AssertCalledWith("%d items", 3)
+
+	r.Case("Testing failing spy assertions")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.NewSpy().AssertCalled() }), "uncalled spy should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		s := pr.NewSpy()
+		s.Fn(1)
+		s.AssertCalledTimes(2)
+	}), "wrong call count should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		s := pr.NewSpy()
+		s.Fn(errors.New("boom"))
+		s.AssertCalledWith("boom")
+	}), "different arguments should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		var notFunc int
+		pr.NewSpy().Func(&notFunc)
+	}), "non-function pointer should fail")
+}
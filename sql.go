@@ -0,0 +1,56 @@
+package got
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// AssertRowsAffected asserts that result reports n affected rows.
+// An error returned by RowsAffected fails the assertion.
+//
+// Example:
+//
+//	res, err := db.Exec("UPDATE users SET active = 1")
+//	r.AssertNoErr(err)
+//	r.AssertRowsAffected(res, 3)
+func (r *R) AssertRowsAffected(result sql.Result, n int64, msg ...string) *R {
+	r.T.Helper()
+	actual, err := result.RowsAffected()
+	if err != nil {
+		r.Fail("Expected %d rows affected, but RowsAffected failed: %v", n, err)
+		return r
+	}
+	r.report(check{
+		ok:      actual == n,
+		pass:    fmt.Sprintf("%d rows affected", n),
+		fail:    fmt.Sprintf("Expected %d rows affected, got %d", n, actual),
+		notPass: fmt.Sprintf("Rows affected is not %d", n),
+		notFail: fmt.Sprintf("Expected rows affected not to be %d", n),
+	}, msg)
+	return r
+}
+
+// AssertLastInsertID asserts that result reports id as the last insert ID.
+// An error returned by LastInsertId fails the assertion.
+//
+// Example:
+//
+//	res, err := db.Exec("INSERT INTO users (name) VALUES (?)", "alice")
+//	r.AssertNoErr(err)
+//	r.AssertLastInsertID(res, 1)
+func (r *R) AssertLastInsertID(result sql.Result, id int64, msg ...string) *R {
+	r.T.Helper()
+	actual, err := result.LastInsertId()
+	if err != nil {
+		r.Fail("Expected last insert ID %d, but LastInsertId failed: %v", id, err)
+		return r
+	}
+	r.report(check{
+		ok:      actual == id,
+		pass:    fmt.Sprintf("Last insert ID is %d", id),
+		fail:    fmt.Sprintf("Expected last insert ID %d, got %d", id, actual),
+		notPass: fmt.Sprintf("Last insert ID is not %d", id),
+		notFail: fmt.Sprintf("Expected last insert ID not to be %d", id),
+	}, msg)
+	return r
+}
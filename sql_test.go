@@ -0,0 +1,39 @@
+package got_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// result is a sql.Result with fixed values
+type result struct {
+	id, rows int64
+	err      error
+}
+
+func (r result) LastInsertId() (int64, error) { return r.id, r.err }
+func (r result) RowsAffected() (int64, error) { return r.rows, r.err }
+
+var _ sql.Result = result{}
+
+// TestAssertRowsAffected tests assertions on sql.Result values
+func TestAssertRowsAffected(t *testing.T) {
+	r := got.New(t, "Test AssertRowsAffected")
+
+	r.Case("Testing matching results")
+	r.AssertRowsAffected(result{id: 7, rows: 2}, 2).
+		AssertLastInsertID(result{id: 7, rows: 2}, 7)
+
+	r.Case("Testing mismatching results")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertRowsAffected(result{rows: 1}, 2) }), "wrong row count should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLastInsertID(result{id: 1}, 2) }), "wrong ID should fail")
+
+	r.Case("Testing result errors")
+	failing := result{err: errors.New("not supported")}
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertRowsAffected(failing, 0) }), "RowsAffected error should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertLastInsertID(failing, 0) }), "LastInsertId error should fail")
+}
@@ -0,0 +1,97 @@
+package sqlt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// Expectations holds the expectations registered by LoadExpectations, in the
+// order of the file, so that results can be attached to them.
+type Expectations struct {
+	Queries []*sqlmock.ExpectedQuery
+	Execs   []*sqlmock.ExpectedExec
+}
+
+// LoadExpectations reads the SQL statements in the file at path and registers
+// an expectation for each of them on mock, in order. Statements end with a
+// semicolon and may span several lines; blank lines and lines starting with
+// "--" are ignored. The leading verb of a statement selects the expectation:
+//
+//   - SELECT, WITH, SHOW, DESCRIBE and EXPLAIN: ExpectQuery, returning no rows
+//   - INSERT, UPDATE, DELETE, REPLACE, CREATE, ALTER, DROP and TRUNCATE:
+//     ExpectExec, returning a result with no affected rows
+//   - BEGIN, COMMIT and ROLLBACK: ExpectBegin, ExpectCommit and ExpectRollback
+//
+// Statements are matched literally, not as regular expressions. Use the
+// returned expectations to attach rows, results or errors. A statement with
+// an unknown verb is reported as an error with its line number.
+//
+// Example:
+//
+//	exp, err := sqlt.LoadExpectations(mockDB.Sqlmock, "testdata/create_user.sql")
+//	exp.Queries[0].WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+func LoadExpectations(mock sqlmock.Sqlmock, path string) (*Expectations, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open expectations: %v", err)
+	}
+	defer f.Close()
+
+	exp := &Expectations{}
+	var stmt strings.Builder
+	start := 0
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "--") {
+			continue
+		}
+		if stmt.Len() == 0 {
+			start = line
+		} else {
+			stmt.WriteByte(' ')
+		}
+		stmt.WriteString(text)
+		if strings.HasSuffix(text, ";") {
+			if err := exp.add(mock, strings.TrimSuffix(stmt.String(), ";"), path, start); err != nil {
+				return nil, err
+			}
+			stmt.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read expectations: %v", err)
+	}
+	if stmt.Len() > 0 {
+		if err := exp.add(mock, stmt.String(), path, start); err != nil {
+			return nil, err
+		}
+	}
+	return exp, nil
+}
+
+// add registers the expectation for a single statement.
+func (e *Expectations) add(mock sqlmock.Sqlmock, stmt, path string, line int) error {
+	stmt = strings.TrimSpace(stmt)
+	verb, _, _ := strings.Cut(stmt, " ")
+	switch strings.ToUpper(verb) {
+	case "SELECT", "WITH", "SHOW", "DESCRIBE", "EXPLAIN":
+		e.Queries = append(e.Queries, mock.ExpectQuery(regexp.QuoteMeta(stmt)).WillReturnRows(sqlmock.NewRows(nil)))
+	case "INSERT", "UPDATE", "DELETE", "REPLACE", "CREATE", "ALTER", "DROP", "TRUNCATE":
+		e.Execs = append(e.Execs, mock.ExpectExec(regexp.QuoteMeta(stmt)).WillReturnResult(sqlmock.NewResult(0, 0)))
+	case "BEGIN":
+		mock.ExpectBegin()
+	case "COMMIT":
+		mock.ExpectCommit()
+	case "ROLLBACK":
+		mock.ExpectRollback()
+	default:
+		return fmt.Errorf("%s:%d: unsupported statement %q", path, line, stmt)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package sqlt
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// writeSQL writes content to a .sql file in a temporary directory
+func writeSQL(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "expectations.sql")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	return path
+}
+
+// TestLoadExpectations tests registering expectations from a SQL file
+func TestLoadExpectations(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+	path := writeSQL(t, `-- create a user
+BEGIN;
+INSERT INTO users (name)
+  VALUES (?);
+SELECT id, name FROM users WHERE id = ?;
+COMMIT;
+`)
+
+	exp, err := LoadExpectations(mockDB.Sqlmock, path)
+	if err != nil {
+		t.Fatalf("LoadExpectations should not return error, got: %v", err)
+	}
+	if len(exp.Queries) != 1 || len(exp.Execs) != 1 {
+		t.Fatalf("Expected 1 query and 1 exec, got %d and %d", len(exp.Queries), len(exp.Execs))
+	}
+	exp.Execs[0].WillReturnResult(sqlmock.NewResult(1, 1))
+	exp.Queries[0].WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "alice"))
+
+	tx, err := mockDB.DB.Begin()
+	if err != nil {
+		t.Fatalf("Begin should not return error, got: %v", err)
+	}
+	res, err := tx.Exec("INSERT INTO users (name) VALUES (?)", "alice")
+	if err != nil {
+		t.Fatalf("Exec should not return error, got: %v", err)
+	}
+	if id, _ := res.LastInsertId(); id != 1 {
+		t.Errorf("Expected last insert ID 1, got %d", id)
+	}
+	var name string
+	if err := tx.QueryRow("SELECT id, name FROM users WHERE id = ?", 1).Scan(new(int), &name); err != nil {
+		t.Fatalf("QueryRow should not return error, got: %v", err)
+	}
+	if name != "alice" {
+		t.Errorf("Expected alice, got %s", name)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Errorf("Commit should not return error, got: %v", err)
+	}
+
+	if err := mockDB.Sqlmock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
+
+// TestLoadExpectationsErrors tests reporting malformed files
+func TestLoadExpectationsErrors(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+
+	_, err = LoadExpectations(mockDB.Sqlmock, writeSQL(t, "SELECT 1;\n\nFROBNICATE users;\n"))
+	if err == nil || !strings.Contains(err.Error(), ":3: unsupported statement") {
+		t.Errorf("Expected an error with the line number, got: %v", err)
+	}
+
+	_, err = LoadExpectations(mockDB.Sqlmock, filepath.Join(t.TempDir(), "missing.sql"))
+	if err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,89 @@
+package sqlt
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go4x/got"
+	"gorm.io/gorm"
+)
+
+// AssertGormCreate checks the create-and-verify round trip of a GORM model:
+// it registers the INSERT that db.Create(model) issues, wrapped in the default
+// transaction unless it is disabled, with returnID as the inserted id, then
+// runs db.Create(model) and asserts through r that the create succeeds and
+// that the model's primary key is returnID afterwards. Time arguments, such as the
+// CreatedAt and UpdatedAt columns set by GORM, are matched with AnyTime.
+// model must be a pointer to a struct with an integer primary key. The
+// assertion is not inverted when r is a view created by got.R.Not.
+//
+// Example:
+//
+//	user := &User{Name: "alice"}
+//	sqlt.AssertGormCreate(r, mockGorm, user, 42)
+//	// user.ID == 42
+func AssertGormCreate(r *got.R, gm *MockGorm, model any, returnID int64, msg ...string) *got.R {
+	r.T.Helper()
+	fail := func(format string, args ...any) *got.R {
+		r.T.Helper()
+		message := fmt.Sprintf(format, args...)
+		if len(msg) > 0 {
+			message = msg[0]
+		}
+		r.Fail(message)
+		return r
+	}
+	rv := reflect.ValueOf(model)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fail("Expected a pointer to a struct model, got %T", model)
+	}
+	// Render the INSERT on a copy, so the model is created only once.
+	dry := reflect.New(rv.Elem().Type())
+	dry.Elem().Set(rv.Elem())
+	stmt := gm.DB.Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true}).Create(dry.Interface())
+	if stmt.Error != nil {
+		return fail("Cannot build the INSERT for %T: %v", model, stmt.Error)
+	}
+	pk := stmt.Statement.Schema.PrioritizedPrimaryField
+	if pk == nil {
+		return fail("Expected %T to have a primary key", model)
+	}
+	args := make([]driver.Value, len(stmt.Statement.Vars))
+	for i, v := range stmt.Statement.Vars {
+		switch v.(type) {
+		case time.Time, *time.Time:
+			args[i] = AnyTime{}
+		default:
+			args[i] = v
+		}
+	}
+
+	tx := !gm.DB.SkipDefaultTransaction
+	if tx {
+		gm.ExpectBegin()
+	}
+	gm.ExpectExec(regexp.QuoteMeta(stmt.Statement.SQL.String())).
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(returnID, 1))
+	if tx {
+		gm.ExpectCommit()
+	}
+
+	if err := gm.DB.Create(model).Error; err != nil {
+		return fail("Expected create of %T to succeed, got: %v", model, err)
+	}
+	id, _ := pk.ValueOf(context.Background(), rv.Elem())
+	switch v := reflect.ValueOf(id); {
+	case v.CanInt() && v.Int() == returnID:
+	case v.CanUint() && returnID >= 0 && v.Uint() == uint64(returnID):
+	default:
+		return fail("Expected %s of the created %T to be %d, got %v", pk.Name, model, returnID, id)
+	}
+	r.Pass("Created %T with %s %d", model, pk.Name, returnID)
+	return r
+}
@@ -0,0 +1,59 @@
+package sqlt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+	"gorm.io/gorm"
+)
+
+type createUser struct {
+	ID        uint
+	Name      string
+	Email     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TestAssertGormCreate tests the create-and-verify helper
+func TestAssertGormCreate(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+	gm, err := mockDB.Gorm()
+	if err != nil {
+		t.Fatalf("Gorm should not return error, got: %v", err)
+	}
+
+	r := got.New(t, "Test AssertGormCreate")
+	user := &createUser{Name: "alice"}
+	AssertGormCreate(r, gm, user, 42)
+	if user.ID != 42 {
+		t.Errorf("Expected the model to get ID 42, got %d", user.ID)
+	}
+	if user.CreatedAt.IsZero() {
+		t.Error("Expected GORM to set CreatedAt")
+	}
+
+	noTx := &MockGorm{MockDB: gm.MockDB, DB: gm.DB.Session(&gorm.Session{SkipDefaultTransaction: true})}
+	AssertGormCreate(r, noTx, &createUser{Name: "bob"}, 7)
+	if err := mockDB.ExpectationsWereMet(); err != nil {
+		t.Errorf("All expectations should be met, got: %v", err)
+	}
+}
+
+// TestAssertGormCreateFailures tests the failures reported by AssertGormCreate
+func TestAssertGormCreateFailures(t *testing.T) {
+	mockDB, _ := NewSqlmock()
+	gm, _ := mockDB.Gorm()
+
+	if !gottest.Probe(func(r *got.R) { AssertGormCreate(r, gm, createUser{}, 1) }) {
+		t.Error("A non-pointer model should fail")
+	}
+	if !gottest.Probe(func(r *got.R) { AssertGormCreate(r, gm, &createUser{}, -1) }) {
+		t.Error("A primary key different from the returned id should fail")
+	}
+}
@@ -0,0 +1,150 @@
+package sqlt
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+)
+
+// GormOption configures the gorm.DB created by MockDB.Gorm.
+type GormOption func(*gormConfig)
+
+// gormConfig holds the settings applied by GormOption values.
+type gormConfig struct {
+	migrations bool
+}
+
+// WithMigrationMatcher makes the gorm.DB answer the schema statements issued
+// by AutoMigrate and the Migrator itself, so that only the business queries
+// need sqlmock expectations. Migration statements are DDL statements such as
+// CREATE TABLE, ALTER TABLE and CREATE INDEX, and queries on
+// information_schema or SELECT DATABASE(). They never reach sqlmock: queries
+// return no rows and execs affect no rows, so AutoMigrate sees an empty
+// database and creates every table.
+//
+// This relaxes strict expectation ordering for migration statements only:
+// they may run at any point and any number of times, while every other
+// statement must still match the registered expectations in order.
+//
+// Example:
+//
+//	mockGorm, _ := mockDB.Gorm(sqlt.WithMigrationMatcher())
+//	mockGorm.DB.AutoMigrate(&User{}) // no expectations needed
+//	mockDB.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(rows)
+func WithMigrationMatcher() GormOption {
+	return func(c *gormConfig) {
+		c.migrations = true
+	}
+}
+
+// migrationPrefixes are the statement prefixes treated as migrations, in
+// upper case.
+var migrationPrefixes = []string{
+	"CREATE TABLE", "ALTER TABLE", "DROP TABLE", "RENAME TABLE",
+	"CREATE INDEX", "CREATE UNIQUE INDEX", "DROP INDEX",
+	"CREATE VIEW", "DROP VIEW", "SHOW ", "SELECT DATABASE()",
+}
+
+// isMigration reports whether query is a schema statement issued by the GORM
+// Migrator.
+func isMigration(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	for _, prefix := range migrationPrefixes {
+		if strings.HasPrefix(q, prefix) {
+			return true
+		}
+	}
+	return strings.Contains(q, "INFORMATION_SCHEMA.")
+}
+
+// migrationPool is a gorm.ConnPool that answers migration statements from an
+// always-empty database and sends every other statement to the sqlmock DB.
+type migrationPool struct {
+	db         *sql.DB
+	migrations *sql.DB
+}
+
+// newMigrationPool wraps db, answering migration statements itself.
+func newMigrationPool(db *sql.DB) *migrationPool {
+	return &migrationPool{db: db, migrations: sql.OpenDB(emptyConnector{})}
+}
+
+// pool returns the database that should run query.
+func (p *migrationPool) pool(query string) *sql.DB {
+	if isMigration(query) {
+		return p.migrations
+	}
+	return p.db
+}
+
+func (p *migrationPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.pool(query).PrepareContext(ctx, query)
+}
+
+func (p *migrationPool) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return p.pool(query).ExecContext(ctx, query, args...)
+}
+
+func (p *migrationPool) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return p.pool(query).QueryContext(ctx, query, args...)
+}
+
+func (p *migrationPool) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return p.pool(query).QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction on the sqlmock DB, so that ExpectBegin works
+// as without the option.
+func (p *migrationPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return p.db.BeginTx(ctx, opts)
+}
+
+// Ping pings the sqlmock DB.
+func (p *migrationPool) Ping() error {
+	return p.db.Ping()
+}
+
+// GetDBConn returns the sqlmock DB, which gorm.DB.DB returns.
+func (p *migrationPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// emptyConnector opens connections to a database without tables: queries
+// return no rows and execs affect no rows.
+type emptyConnector struct{}
+
+func (emptyConnector) Connect(context.Context) (driver.Conn, error) { return emptyConn{}, nil }
+func (emptyConnector) Driver() driver.Driver                        { return emptyDriver{} }
+
+type emptyDriver struct{}
+
+func (emptyDriver) Open(string) (driver.Conn, error) { return emptyConn{}, nil }
+
+type emptyConn struct{}
+
+func (emptyConn) Prepare(query string) (driver.Stmt, error) { return emptyStmt{}, nil }
+func (emptyConn) Close() error                              { return nil }
+func (emptyConn) Begin() (driver.Tx, error)                 { return emptyTx{}, nil }
+
+// CheckNamedValue accepts arguments of any type, since they are ignored.
+func (emptyConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+type emptyStmt struct{}
+
+func (emptyStmt) Close() error                               { return nil }
+func (emptyStmt) NumInput() int                              { return -1 }
+func (emptyStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (emptyStmt) Query([]driver.Value) (driver.Rows, error)  { return emptyRows{}, nil }
+
+type emptyTx struct{}
+
+func (emptyTx) Commit() error   { return nil }
+func (emptyTx) Rollback() error { return nil }
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
@@ -0,0 +1,106 @@
+package sqlt
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go4x/got"
+	"gorm.io/gorm"
+)
+
+// recorders numbers the callbacks registered by NewQueryRecorder, so that
+// several recorders can watch the same gorm.DB.
+var recorders atomic.Int64
+
+// QueryRecorder records the SQL statements built by a gorm.DB, with their
+// placeholders, in the order they were run. Statements are recorded even if
+// they fail, such as when sqlmock rejects them, and in DryRun sessions, so a
+// query builder can be tested without setting expectations. It is safe for
+// concurrent use.
+type QueryRecorder struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+// NewQueryRecorder starts recording the statements run through db and the
+// sessions derived from it.
+//
+// Example:
+//
+//	rec := sqlt.NewQueryRecorder(mockGorm.DB)
+//	dry := mockGorm.DB.Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true})
+//	repo.Search(dry, Filter{Active: true})
+//	sqlt.AssertSQLContains(r, rec, "WHERE `active` = ?")
+func NewQueryRecorder(db *gorm.DB) *QueryRecorder {
+	rec := &QueryRecorder{}
+	name := fmt.Sprintf("sqlt:record_%d", recorders.Add(1))
+	cb := db.Callback()
+	_ = cb.Create().After("gorm:create").Register(name, rec.record)
+	_ = cb.Query().After("gorm:query").Register(name, rec.record)
+	_ = cb.Update().After("gorm:update").Register(name, rec.record)
+	_ = cb.Delete().After("gorm:delete").Register(name, rec.record)
+	_ = cb.Row().After("gorm:row").Register(name, rec.record)
+	_ = cb.Raw().After("gorm:raw").Register(name, rec.record)
+	return rec
+}
+
+// record is the GORM callback appending the statement of db.
+func (q *QueryRecorder) record(db *gorm.DB) {
+	if db.Statement == nil || db.Statement.SQL.Len() == 0 {
+		return
+	}
+	q.mu.Lock()
+	q.queries = append(q.queries, db.Statement.SQL.String())
+	q.mu.Unlock()
+}
+
+// Queries returns the recorded statements, in order.
+func (q *QueryRecorder) Queries() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]string(nil), q.queries...)
+}
+
+// Reset discards the recorded statements.
+func (q *QueryRecorder) Reset() {
+	q.mu.Lock()
+	q.queries = nil
+	q.mu.Unlock()
+}
+
+// AssertSQLContains asserts through r that one of the statements recorded by
+// rec contains substr. Runs of whitespace are collapsed to a single space in
+// both, so the check does not depend on how the statement is laid out. On
+// failure, all the recorded statements are listed. Like the other assertions
+// of this package, it does not honor got.R.Not.
+//
+// Example:
+//
+//	sqlt.AssertSQLContains(r, rec, "ORDER BY `created_at` DESC")
+func AssertSQLContains(r *got.R, rec *QueryRecorder, substr string, msg ...string) *got.R {
+	r.T.Helper()
+	queries := rec.Queries()
+	want := normalizeSQL(substr)
+	for _, q := range queries {
+		if strings.Contains(normalizeSQL(q), want) {
+			r.Pass("A recorded query contains %q", substr)
+			return r
+		}
+	}
+	message := fmt.Sprintf("Expected a query containing %q, got:\n\t%s", substr, strings.Join(queries, "\n\t"))
+	if len(queries) == 0 {
+		message = fmt.Sprintf("Expected a query containing %q, but no query was recorded", substr)
+	}
+	if len(msg) > 0 {
+		message = msg[0]
+	}
+	r.Fail(message)
+	return r
+}
+
+// normalizeSQL collapses the runs of whitespace in query to single spaces.
+func normalizeSQL(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
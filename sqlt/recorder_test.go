@@ -0,0 +1,51 @@
+package sqlt
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+	"gorm.io/gorm"
+)
+
+// TestQueryRecorder tests recording the statements built by GORM
+func TestQueryRecorder(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+	gm, err := mockDB.Gorm()
+	if err != nil {
+		t.Fatalf("Gorm should not return error, got: %v", err)
+	}
+	rec := NewQueryRecorder(gm.DB)
+
+	dry := gm.DB.Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true})
+	var users []createUser
+	dry.Where("name = ?", "alice").Order("created_at DESC").Find(&users)
+	dry.Model(&createUser{}).Where("id = ?", 1).Update("name", "bob")
+
+	if n := len(rec.Queries()); n != 2 {
+		t.Fatalf("Expected 2 recorded queries, got %d: %v", n, rec.Queries())
+	}
+	r := got.New(t, "Test QueryRecorder")
+	AssertSQLContains(r, rec, "WHERE name = ?")
+	AssertSQLContains(r, rec, "ORDER BY   created_at\n DESC")
+	AssertSQLContains(r, rec, "UPDATE `create_users` SET")
+
+	// failing statements are recorded too
+	gm.DB.Where("email IS NULL").Find(&users)
+	AssertSQLContains(r, rec, "WHERE email IS NULL")
+
+	if !gottest.Probe(func(r *got.R) { AssertSQLContains(r, rec, "LIMIT 1") }) {
+		t.Error("A missing clause should fail")
+	}
+
+	rec.Reset()
+	if len(rec.Queries()) != 0 {
+		t.Errorf("Expected no queries after Reset, got %v", rec.Queries())
+	}
+	if !gottest.Probe(func(r *got.R) { AssertSQLContains(r, rec, "SELECT") }) {
+		t.Error("An empty recorder should fail")
+	}
+}
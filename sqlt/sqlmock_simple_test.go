@@ -1,6 +1,8 @@
 package sqlt
 
 import (
+	"database/sql/driver"
+	"errors"
 	"testing"
 	"time"
 
@@ -27,6 +29,32 @@ func TestAnyTime(t *testing.T) {
 	}
 }
 
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+// TestAtTime tests the AtTime matcher
+func TestAtTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	atTime := AtTime{Clock: fixedClock(now)}
+
+	if !atTime.Match(now) {
+		t.Error("AtTime should match the clock's current time")
+	}
+
+	if !atTime.Match(now.In(time.FixedZone("UTC+8", 8*3600))) {
+		t.Error("AtTime should match the same instant in another location")
+	}
+
+	if atTime.Match(now.Add(time.Second)) {
+		t.Error("AtTime should not match a different time")
+	}
+
+	if atTime.Match("not a time") {
+		t.Error("AtTime should not match non-time values")
+	}
+}
+
 // TestNewSqlmock tests the NewSqlmock function
 func TestNewSqlmock(t *testing.T) {
 	mockDB, err := NewSqlmock()
@@ -67,6 +95,58 @@ func TestGorm(t *testing.T) {
 	}
 }
 
+// TestGormWithMigrationMatcher tests that migrations need no expectations
+func TestGormWithMigrationMatcher(t *testing.T) {
+	type user struct {
+		ID    uint   `gorm:"primaryKey"`
+		Email string `gorm:"uniqueIndex;size:191"`
+	}
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+	gormMock, err := mockDB.Gorm(WithMigrationMatcher())
+	if err != nil {
+		t.Fatalf("Gorm should not return error, got: %v", err)
+	}
+
+	if err := gormMock.DB.AutoMigrate(&user{}); err != nil {
+		t.Errorf("AutoMigrate should succeed without expectations, got: %v", err)
+	}
+	mockDB.ExpectQuery("SELECT \\* FROM `users`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, "a@example.com"))
+	var users []user
+	if err := gormMock.DB.Find(&users).Error; err != nil {
+		t.Errorf("Find should succeed, got: %v", err)
+	}
+	if len(users) != 1 || users[0].Email != "a@example.com" {
+		t.Errorf("Find should return the mocked user, got: %v", users)
+	}
+	if err := mockDB.ExpectationsWereMet(); err != nil {
+		t.Errorf("All expectations should be met, got: %v", err)
+	}
+	if db, err := gormMock.DB.DB(); err != nil || db != mockDB.DB {
+		t.Errorf("DB should return the sqlmock database, got: %v, %v", db, err)
+	}
+}
+
+// TestIsMigration tests the classification of migration statements
+func TestIsMigration(t *testing.T) {
+	tests := map[string]bool{
+		"CREATE TABLE `users` (`id` bigint)":                                    true,
+		"  alter table users add column x":                                      true,
+		"SELECT DATABASE()":                                                     true,
+		"SELECT count(*) FROM information_schema.tables WHERE table_schema = ?": true,
+		"SELECT * FROM `users`":                                                 false,
+		"INSERT INTO `users` (`email`) VALUES (?)":                              false,
+	}
+	for query, want := range tests {
+		if got := isMigration(query); got != want {
+			t.Errorf("isMigration(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
+
 // TestMockDBOperations tests basic mock operations
 func TestMockDBOperations(t *testing.T) {
 	mockDB, err := NewSqlmock()
@@ -122,3 +202,64 @@ func TestMockDBExec(t *testing.T) {
 		t.Errorf("Mock expectations were not met: %v", err)
 	}
 }
+
+// TestNewSqlmockPing tests ping expectations with ping monitoring enabled
+func TestNewSqlmockPing(t *testing.T) {
+	mockDB, err := NewSqlmockPing()
+	if err != nil {
+		t.Fatalf("NewSqlmockPing should not return error, got: %v", err)
+	}
+
+	mockDB.Sqlmock.ExpectPing()
+	if err := mockDB.DB.Ping(); err != nil {
+		t.Errorf("Ping should not return error, got: %v", err)
+	}
+
+	pingErr := errors.New("connection refused")
+	mockDB.Sqlmock.ExpectPing().WillReturnError(pingErr)
+	if err := mockDB.DB.Ping(); !errors.Is(err, pingErr) {
+		t.Errorf("Ping should return the expected error, got: %v", err)
+	}
+
+	if err := mockDB.Sqlmock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
+
+// TestRowsWithError tests a query failing after some rows have been read
+func TestRowsWithError(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+
+	readErr := errors.New("connection reset")
+	mockDB.Sqlmock.ExpectQuery("SELECT id FROM users").
+		WillReturnRows(RowsWithError([]string{"id"}, [][]driver.Value{{1}, {2}, {3}}, 2, readErr))
+
+	rows, err := mockDB.DB.Query("SELECT id FROM users")
+	if err != nil {
+		t.Fatalf("Query should not return error, got: %v", err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("Scan should not return error, got: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if !errors.Is(rows.Err(), readErr) {
+		t.Errorf("rows.Err should return the configured error, got: %v", rows.Err())
+	}
+	if len(ids) != 2 {
+		t.Errorf("Expected 2 rows before the error, got %v", ids)
+	}
+	if err := rows.Close(); err != nil {
+		t.Errorf("Close should not return error, got: %v", err)
+	}
+
+	if err := mockDB.Sqlmock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Mock expectations were not met: %v", err)
+	}
+}
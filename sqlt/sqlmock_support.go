@@ -20,9 +20,28 @@ func (a AnyTime) Match(v driver.Value) bool {
 	return ok
 }
 
+// Clock is a source of the current time, such as got.FakeClock.
+type Clock interface {
+	Now() time.Time
+}
+
+// AtTime matches a time argument equal to the clock's current time at the
+// moment the query is executed. Pair it with a fake clock to assert the exact
+// timestamps written by the code under test.
+type AtTime struct {
+	Clock Clock
+}
+
+// Match satisfies sqlmock.Argument interface
+func (a AtTime) Match(v driver.Value) bool {
+	t, ok := v.(time.Time)
+	return ok && t.Equal(a.Clock.Now())
+}
+
 type MockDB struct {
 	*sql.DB
 	sqlmock.Sqlmock
+	calls *callLog // calls sqlmock did not expect, see AssertAllMet
 }
 
 type MockGorm struct {
@@ -31,17 +50,31 @@ type MockGorm struct {
 }
 
 func NewSqlmock() (*MockDB, error) {
-	db, mock, err := sqlmock.New() // mock db
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sqlmock: %v", err)
-	}
-	return &MockDB{DB: db, Sqlmock: mock}, nil
+	return openMock(false)
+}
+
+// NewSqlmockPing creates a MockDB with ping monitoring enabled.
+// Unlike NewSqlmock, where pings always succeed and ExpectPing has no effect,
+// every db.Ping() must be matched by an ExpectPing expectation, so connection
+// health checks can be asserted and made to fail.
+func NewSqlmockPing() (*MockDB, error) {
+	return openMock(true)
 }
 
-func (m *MockDB) Gorm() (*MockGorm, error) {
+// Gorm opens a gorm.DB on the mock database using the MySQL dialect.
+// Options such as WithMigrationMatcher change how statements reach sqlmock.
+func (m *MockDB) Gorm(opts ...GormOption) (*MockGorm, error) {
+	var cfg gormConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	var conn gorm.ConnPool = m.DB
+	if cfg.migrations {
+		conn = newMigrationPool(m.DB)
+	}
 	// create gorm.DB
 	db, err := gorm.Open(mysql.New(mysql.Config{
-		Conn:                      m.DB,
+		Conn:                      conn,
 		SkipInitializeWithVersion: true,
 	}), &gorm.Config{})
 	if err != nil {
@@ -49,3 +82,21 @@ func (m *MockDB) Gorm() (*MockGorm, error) {
 	}
 	return &MockGorm{MockDB: m, DB: db}, nil
 }
+
+// RowsWithError builds sqlmock rows with the given columns and values whose
+// iteration fails when row failAt (0-based) is reached: rows.Next returns
+// false and rows.Err returns err, after the rows before failAt have been read.
+// Use it to test that streaming code handles errors and cleans up after a
+// partial read.
+//
+// Example:
+//
+//	rows := sqlt.RowsWithError([]string{"id"}, [][]driver.Value{{1}, {2}, {3}}, 2, io.ErrUnexpectedEOF)
+//	mockDB.ExpectQuery("SELECT id FROM users").WillReturnRows(rows)
+func RowsWithError(cols []string, rows [][]driver.Value, failAt int, err error) *sqlmock.Rows {
+	r := sqlmock.NewRows(cols)
+	for _, row := range rows {
+		r.AddRow(row...)
+	}
+	return r.RowError(failAt, err)
+}
@@ -0,0 +1,209 @@
+package sqlt
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go4x/got"
+)
+
+// mockDSNs numbers the data source names of the databases opened by openMock.
+var mockDSNs atomic.Int64
+
+// openMock creates a sqlmock database whose connection records the calls that
+// sqlmock rejects as unexpected, so that AssertAllMet can report them even if
+// the code under test swallowed the errors. monitorPings enables
+// sqlmock.MonitorPingsOption.
+func openMock(monitorPings bool) (*MockDB, error) {
+	dsn := fmt.Sprintf("sqlt_db_%d", mockDSNs.Add(1))
+	raw, mock, err := sqlmock.NewWithDSN(dsn, sqlmock.MonitorPingsOption(monitorPings))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sqlmock: %v", err)
+	}
+	calls := &callLog{}
+	db := sql.OpenDB(&recordingConnector{drv: raw.Driver(), dsn: dsn, calls: calls})
+	return &MockDB{DB: db, Sqlmock: mock, calls: calls}, nil
+}
+
+// callLog collects the errors of the calls sqlmock did not expect.
+type callLog struct {
+	mu         sync.Mutex
+	unexpected []string
+}
+
+// record logs err if it reports an unexpected call, and returns it.
+func (l *callLog) record(err error) error {
+	if err != nil && strings.Contains(err.Error(), "was not expected") {
+		l.mu.Lock()
+		l.unexpected = append(l.unexpected, err.Error())
+		l.mu.Unlock()
+	}
+	return err
+}
+
+// list returns the logged errors.
+func (l *callLog) list() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.unexpected...)
+}
+
+// AssertAllMet asserts through r that every expectation registered on mock
+// was met and that no unexpected call reached it. Unexpected calls fail at
+// call time, but the code under test may swallow the error; they are
+// recorded and reported here, separately from the unmet expectations.
+// mock must have been created with NewSqlmock or NewSqlmockPing for
+// unexpected calls to be reported. A negated r does not invert the check.
+//
+// Example:
+//
+//	mockDB.ExpectExec("UPDATE users").WillReturnResult(sqlmock.NewResult(0, 1))
+//	repo.Deactivate(mockDB.DB, 1)
+//	sqlt.AssertAllMet(r, mockDB)
+func AssertAllMet(r *got.R, mock *MockDB, msg ...string) *got.R {
+	r.T.Helper()
+	var problems []string
+	if err := mock.ExpectationsWereMet(); err != nil {
+		problems = append(problems, "unmet expectation: "+err.Error())
+	}
+	if mock.calls != nil {
+		for _, call := range mock.calls.list() {
+			problems = append(problems, "unexpected call: "+call)
+		}
+	}
+	if len(problems) == 0 {
+		r.Pass("All SQL expectations were met and no unexpected call was made")
+		return r
+	}
+	message := "Expected all SQL expectations to be met without unexpected calls, but:\n\t" + strings.Join(problems, "\n\t")
+	if len(msg) > 0 {
+		message = msg[0]
+	}
+	r.Fail(message)
+	return r
+}
+
+// mockConn is the set of interfaces implemented by sqlmock connections.
+type mockConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+	driver.NamedValueChecker
+}
+
+// recordingConnector opens the sqlmock connection of dsn, wrapped to record
+// unexpected calls.
+type recordingConnector struct {
+	drv   driver.Driver
+	dsn   string
+	calls *callLog
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	conn, err := c.drv.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	mc, ok := conn.(mockConn)
+	if !ok {
+		return nil, fmt.Errorf("unsupported sqlmock connection %T", conn)
+	}
+	return &recordingConn{conn: mc, calls: c.calls}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver { return c.drv }
+
+// recordingConn forwards to a sqlmock connection, recording unexpected calls.
+type recordingConn struct {
+	conn  mockConn
+	calls *callLog
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *recordingConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.conn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, c.calls.record(err)
+	}
+	return &recordingStmt{stmt: stmt, calls: c.calls}, nil
+}
+
+func (c *recordingConn) Close() error { return c.calls.record(c.conn.Close()) }
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *recordingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, c.calls.record(err)
+	}
+	return &recordingTx{tx: tx, calls: c.calls}, nil
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.conn.ExecContext(ctx, query, args)
+	return res, c.calls.record(err)
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.conn.QueryContext(ctx, query, args)
+	return rows, c.calls.record(err)
+}
+
+func (c *recordingConn) Ping(ctx context.Context) error { return c.calls.record(c.conn.Ping(ctx)) }
+
+func (c *recordingConn) CheckNamedValue(nv *driver.NamedValue) error {
+	return c.conn.CheckNamedValue(nv)
+}
+
+// recordingStmt forwards to a sqlmock statement, recording unexpected calls.
+type recordingStmt struct {
+	stmt  driver.Stmt
+	calls *callLog
+}
+
+func (s *recordingStmt) Close() error  { return s.stmt.Close() }
+func (s *recordingStmt) NumInput() int { return s.stmt.NumInput() }
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	res, err := s.stmt.Exec(args)
+	return res, s.calls.record(err)
+}
+
+func (s *recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows, err := s.stmt.Query(args)
+	return rows, s.calls.record(err)
+}
+
+func (s *recordingStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	res, err := s.stmt.(driver.StmtExecContext).ExecContext(ctx, args)
+	return res, s.calls.record(err)
+}
+
+func (s *recordingStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	return rows, s.calls.record(err)
+}
+
+// recordingTx forwards to a sqlmock transaction, recording unexpected calls.
+type recordingTx struct {
+	tx    driver.Tx
+	calls *callLog
+}
+
+func (t *recordingTx) Commit() error   { return t.calls.record(t.tx.Commit()) }
+func (t *recordingTx) Rollback() error { return t.calls.record(t.tx.Rollback()) }
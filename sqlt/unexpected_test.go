@@ -0,0 +1,68 @@
+package sqlt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertAllMet tests reporting unmet expectations and unexpected calls
+func TestAssertAllMet(t *testing.T) {
+	mockDB, err := NewSqlmock()
+	if err != nil {
+		t.Fatalf("NewSqlmock should not return error, got: %v", err)
+	}
+	mockDB.ExpectExec("UPDATE users").WillReturnResult(sqlmock.NewResult(0, 1))
+	if _, err := mockDB.Exec("UPDATE users SET active = 0"); err != nil {
+		t.Fatalf("Expected exec should succeed, got: %v", err)
+	}
+	AssertAllMet(got.New(t, "Test AssertAllMet"), mockDB)
+
+	// an unexpected call whose error is swallowed by the code under test
+	_, _ = mockDB.Exec("DELETE FROM users")
+	failed, out := gottest.Detached(func(r *got.R) { AssertAllMet(r, mockDB) })
+	if !failed {
+		t.Error("An unexpected call should fail")
+	}
+	if !strings.Contains(out, "unexpected call:") || !strings.Contains(out, "DELETE FROM users") {
+		t.Errorf("Expected the unexpected call to be reported, got: %s", out)
+	}
+
+	mockDB, _ = NewSqlmock()
+	mockDB.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	failed, out = gottest.Detached(func(r *got.R) { AssertAllMet(r, mockDB) })
+	if !failed {
+		t.Error("An unmet expectation should fail")
+	}
+	if !strings.Contains(out, "unmet expectation:") || strings.Contains(out, "unexpected call:") {
+		t.Errorf("Expected only the unmet expectation to be reported, got: %s", out)
+	}
+}
+
+// TestAssertAllMetTransactions tests recording unexpected calls in
+// transactions and prepared statements
+func TestAssertAllMetTransactions(t *testing.T) {
+	mockDB, _ := NewSqlmock()
+	mockDB.ExpectBegin()
+	mockDB.ExpectPrepare("INSERT INTO users")
+	tx, err := mockDB.Begin()
+	if err != nil {
+		t.Fatalf("Expected begin should succeed, got: %v", err)
+	}
+	stmt, err := tx.Prepare("INSERT INTO users (name) VALUES (?)")
+	if err != nil {
+		t.Fatalf("Expected prepare should succeed, got: %v", err)
+	}
+	if _, err := stmt.Exec("alice"); err == nil {
+		t.Error("An unexpected statement execution should fail")
+	}
+	if err := tx.Commit(); err == nil {
+		t.Error("An unexpected commit should fail")
+	}
+	if n := len(mockDB.calls.list()); n != 2 {
+		t.Errorf("Expected 2 unexpected calls, got %d: %v", n, mockDB.calls.list())
+	}
+}
@@ -0,0 +1,182 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AssertEqualIgnoreFields asserts that expected and actual are deeply equal
+// after zeroing the named fields on both sides. The values must be structs or
+// pointers to structs of the same type; nested fields are named with dotted
+// paths such as "Meta.UpdatedAt", following pointers along the way. Neither
+// value is modified. On failure, the remaining differing fields are reported.
+//
+// Example:
+//
+//	got, _ := repo.Find(ctx, id)
+//	r.AssertEqualIgnoreFields(want, got, []string{"ID", "CreatedAt", "Meta.UpdatedAt"})
+func (r *R) AssertEqualIgnoreFields(expected, actual any, fields []string, msg ...string) *R {
+	r.T.Helper()
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !ev.IsValid() || !av.IsValid() || ev.Type() != av.Type() {
+		r.Fail("Expected values of the same struct type, got %T and %T", expected, actual)
+		return r
+	}
+	ec, ac := cloneValue(ev), cloneValue(av)
+	for _, field := range fields {
+		if err := zeroField(ec, field); err != nil {
+			r.Fail("Cannot ignore field: %v", err)
+			return r
+		}
+		if err := zeroField(ac, field); err != nil {
+			r.Fail("Cannot ignore field: %v", err)
+			return r
+		}
+	}
+	ok := reflect.DeepEqual(ec.Interface(), ac.Interface())
+	fail := ""
+	if !ok {
+		var diffs []string
+		fieldDiff("", reflect.Indirect(ec), reflect.Indirect(ac), &diffs)
+		fail = fmt.Sprintf("Expected values to be equal ignoring %s, but:\n\t%s",
+			strings.Join(fields, ", "), strings.Join(diffs, "\n\t"))
+	}
+	r.report(check{
+		ok:      ok,
+		pass:    fmt.Sprintf("Values are equal ignoring %s", strings.Join(fields, ", ")),
+		fail:    fail,
+		notPass: fmt.Sprintf("Values differ ignoring %s", strings.Join(fields, ", ")),
+		notFail: fmt.Sprintf("Expected values to differ ignoring %s", strings.Join(fields, ", ")),
+	}, msg)
+	return r
+}
+
+// cloneValue returns an addressable copy of v. Pointers to structs are copied
+// one level deep so that fields can be zeroed without touching the original.
+func cloneValue(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(v.Elem())
+		return c
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// zeroField sets the field at the dotted path inside v to its zero value.
+// Pointers along the path are copied before descending, so the values they
+// point to are never modified. Nil pointers along the path are left as is.
+func zeroField(v reflect.Value, path string) error {
+	name, rest, nested := strings.Cut(path, ".")
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		if v.CanSet() {
+			c := reflect.New(v.Type().Elem())
+			c.Elem().Set(v.Elem())
+			v.Set(c)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("%q: %s is not a struct", path, v.Type())
+	}
+	f := v.FieldByName(name)
+	switch {
+	case !f.IsValid():
+		return fmt.Errorf("%s has no field %q", v.Type(), name)
+	case !f.CanSet():
+		return fmt.Errorf("field %q of %s is not exported", name, v.Type())
+	case nested:
+		return zeroField(f, rest)
+	}
+	f.SetZero()
+	return nil
+}
+
+// AssertStructTag asserts that the field of a struct, or pointer to a struct,
+// has the tag key with the value expected, such as "name,omitempty" for the
+// tag `json:"name,omitempty"`. Nested fields are named with dotted paths such
+// as "Address.Street", following pointers, slices and arrays along the way;
+// fields promoted from embedded structs can be named directly. On failure,
+// the actual tag value is reported.
+//
+// Example:
+//
+//	r.AssertStructTag(User{}, "Email", "json", "email,omitempty")
+//	r.AssertStructTag(&Order{}, "Items.Price", "db", "price")
+func (r *R) AssertStructTag(structVal any, field, tagKey, expected string, msg ...string) *R {
+	r.T.Helper()
+	if structVal == nil {
+		r.Fail("Expected a struct, got nil")
+		return r
+	}
+	f, err := structField(reflect.TypeOf(structVal), field)
+	if err != nil {
+		r.Fail("Cannot look up field: %v", err)
+		return r
+	}
+	actual, ok := f.Tag.Lookup(tagKey)
+	fail := fmt.Sprintf("Expected %s tag of %s to be %q, got %q", tagKey, field, expected, actual)
+	if !ok {
+		fail = fmt.Sprintf("Expected %s tag of %s to be %q, but the field has no %s tag (tags: `%s`)", tagKey, field, expected, tagKey, f.Tag)
+	}
+	r.report(check{
+		ok:      ok && actual == expected,
+		pass:    fmt.Sprintf("%s tag of %s is %q", tagKey, field, expected),
+		fail:    fail,
+		notPass: fmt.Sprintf("%s tag of %s is %q, not %q", tagKey, field, actual, expected),
+		notFail: fmt.Sprintf("Expected %s tag of %s not to be %q", tagKey, field, expected),
+	}, msg)
+	return r
+}
+
+// structField returns the field at the dotted path inside the struct type t,
+// looking through pointers, slices and arrays.
+func structField(t reflect.Type, path string) (reflect.StructField, error) {
+	name, rest, nested := strings.Cut(path, ".")
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, fmt.Errorf("%q: %s is not a struct", path, t)
+	}
+	f, ok := t.FieldByName(name)
+	switch {
+	case !ok:
+		return reflect.StructField{}, fmt.Errorf("%s has no field %q", t, name)
+	case nested:
+		return structField(f.Type, rest)
+	}
+	return f, nil
+}
+
+// fieldDiff appends a line to diffs for every exported field that differs
+// between a and b, descending into nested structs and pointers. Values that
+// differ only in unexported fields are reported as a whole.
+func fieldDiff(path string, a, b reflect.Value, diffs *[]string) {
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return
+	}
+	if a.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() {
+		fieldDiff(path, a.Elem(), b.Elem(), diffs)
+		return
+	}
+	n := len(*diffs)
+	if a.Kind() == reflect.Struct {
+		for i := 0; i < a.NumField(); i++ {
+			if f := a.Type().Field(i); f.IsExported() {
+				fieldDiff(strings.TrimPrefix(path+"."+f.Name, "."), a.Field(i), b.Field(i), diffs)
+			}
+		}
+	}
+	if len(*diffs) == n {
+		if path == "" {
+			path = a.Type().String()
+		}
+		*diffs = append(*diffs, fmt.Sprintf("%s: expected %+v, got %+v", path, a, b))
+	}
+}
@@ -0,0 +1,84 @@
+package got_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+type auditMeta struct {
+	UpdatedAt time.Time
+	Version   int
+}
+
+type auditUser struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	Meta      *auditMeta
+}
+
+// TestAssertEqualIgnoreFields tests struct comparison ignoring volatile fields
+func TestAssertEqualIgnoreFields(t *testing.T) {
+	r := got.New(t, "Test AssertEqualIgnoreFields")
+	want := auditUser{Name: "alice", Meta: &auditMeta{Version: 1}}
+	actual := auditUser{ID: 7, Name: "alice", CreatedAt: time.Now(), Meta: &auditMeta{UpdatedAt: time.Now(), Version: 1}}
+
+	r.Case("Testing equal values ignoring fields")
+	r.AssertEqualIgnoreFields(want, actual, []string{"ID", "CreatedAt", "Meta.UpdatedAt"})
+	r.AssertEqualIgnoreFields(&want, &actual, []string{"ID", "CreatedAt", "Meta.UpdatedAt"})
+	r.AssertEqualIgnoreFields(auditUser{}, auditUser{ID: 1}, []string{"ID", "Meta.UpdatedAt"})
+	r.AssertFalse(actual.Meta.UpdatedAt.IsZero() || actual.ID == 0, "original values should not be modified")
+
+	r.Case("Testing values that still differ")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertEqualIgnoreFields(want, actual, []string{"ID", "CreatedAt"})
+	}), "unignored nested field should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.AssertEqualIgnoreFields(want, auditUser{Name: "bob", Meta: &auditMeta{Version: 1}}, []string{"ID"})
+	}), "different name should fail")
+
+	r.Case("Testing invalid arguments")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertEqualIgnoreFields(want, actual, []string{"Missing"}) }), "unknown field should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertEqualIgnoreFields(want, &actual, nil) }), "different types should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertEqualIgnoreFields(want, actual, []string{"Name.First"}) }), "non-struct path should fail")
+}
+
+type taggedBase struct {
+	ID int `json:"id" db:"id"`
+}
+
+type taggedItem struct {
+	Price float64 `json:"price" db:"price"`
+}
+
+type taggedOrder struct {
+	taggedBase
+	Email   string       `json:"email,omitempty" validate:"required,email"`
+	Items   []taggedItem `json:"items"`
+	Billing *taggedItem  `json:"billing"`
+	Notes   string
+}
+
+// TestAssertStructTag tests asserting on struct field tags
+func TestAssertStructTag(t *testing.T) {
+	r := got.New(t, "Test AssertStructTag")
+
+	r.Case("Testing matching tags")
+	r.AssertStructTag(taggedOrder{}, "Email", "json", "email,omitempty").
+		AssertStructTag(&taggedOrder{}, "Email", "validate", "required,email").
+		AssertStructTag(taggedOrder{}, "ID", "db", "id").
+		AssertStructTag(taggedOrder{}, "taggedBase.ID", "json", "id").
+		AssertStructTag(taggedOrder{}, "Items.Price", "db", "price").
+		AssertStructTag(taggedOrder{}, "Billing.Price", "json", "price")
+	r.Not().AssertStructTag(taggedOrder{}, "Email", "json", "email")
+
+	r.Case("Testing mismatching tags and invalid paths")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertStructTag(taggedOrder{}, "Email", "json", "mail") }), "wrong tag should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertStructTag(taggedOrder{}, "Notes", "json", "notes") }), "missing tag should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertStructTag(taggedOrder{}, "Missing", "json", "x") }), "missing field should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertStructTag(taggedOrder{}, "Email.Len", "json", "x") }), "non-struct path should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertStructTag(nil, "Email", "json", "x") }), "nil should fail")
+}
@@ -0,0 +1,130 @@
+package got
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// subRunners maps the subtests started through a runner to their runners.
+type subRunners struct {
+	mu  sync.Mutex
+	byT map[*testing.T]*R
+}
+
+// Sub returns the runner of the subtest t started by Run, Caser, It or Cases.
+// The subtest runner reports through t, numbers its cases below the case that
+// started the subtest, as in "Case 2.1 -> ", and counts its own failures.
+// While a subtest runs without calling t.Parallel, the cases started on r are
+// numbered by its runner as well, so Sub is only needed by parallel subtests,
+// whose cases would otherwise be numbered concurrently with their siblings.
+// For a t that was not started through r, Sub returns r.
+//
+// Parameters:
+//   - t: The testing.T of the subtest
+//
+// Returns:
+//   - *R: The runner of the subtest
+//
+// Example:
+//
+//	r.Caser("parallel", func(tt *testing.T) {
+//		tt.Parallel()
+//		sr := r.Sub(tt)
+//		sr.Case("numbered 1.1")
+//		sr.AssertEqual(4, 2*2)
+//	})
+func (r *R) Sub(t *testing.T) *R {
+	subs := r.root().subs
+	if subs == nil {
+		return r
+	}
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+	if sr, ok := subs.byT[t]; ok {
+		return sr
+	}
+	return r
+}
+
+// scope returns the runner numbering the cases started on r: the runner of
+// the innermost subtest running synchronously, or the root runner.
+func (r *R) scope() *R {
+	s := r.root()
+	for s.active != nil {
+		s = s.active
+	}
+	return s
+}
+
+// run runs f as a subtest named name with a child runner, whose cases are
+// numbered below the current case. The child numbers the cases started on r
+// until the subtest returns or calls t.Parallel.
+func (r *R) run(name string, f func(sr *R)) {
+	r.T.Helper()
+	s := r.scope()
+	path := s.path
+	if s.caseNum > 0 {
+		path += strconv.Itoa(s.caseNum) + "."
+	}
+	nested := s.depth > 0
+	r.T.Run(name, func(tt *testing.T) {
+		r.T.Helper()
+		sr := s.child(tt, path)
+		start := time.Now()
+		defer func() { sr.recordTiming(tt.Name(), nested, time.Since(start)) }()
+		defer sr.endCase(sr.depth)
+		defer func() {
+			// A panic kills the test process: run the teardown of Main first.
+			if v := recover(); v != nil {
+				mainTeardown()
+				panic(v)
+			}
+		}()
+		s.active = sr
+		f(sr)
+	})
+	s.active = nil
+}
+
+// child creates the runner of the subtest t, numbering its cases with path.
+// The child shares the configuration, reporter, output buffer, clock and
+// subtest timings of r, and copies its BeforeEach and AfterEach callbacks; its
+// case numbers, failures, random source and goroutines started with Go are its
+// own.
+func (r *R) child(t *testing.T, path string) *R {
+	root := r.root()
+	sr := &R{
+		T:         t,
+		title:     root.title,
+		path:      path,
+		depth:     r.depth + 1,
+		subject:   r.subject,
+		startTime: time.Now(),
+		buf:       root.buf,
+		clock:     root.clock,
+		cfg:       root.cfg,
+		timings:   root.timings,
+		only:      root.only,
+		spawned:   &goroutineGroup{},
+		subs:      root.subs,
+	}
+	if root.rng != nil {
+		seed := root.seed
+		sr.baseSeed = &seed
+	}
+	if h := root.hooks; h != nil {
+		sr.hooks = &caseHooks{before: h.before, after: h.after}
+	}
+	subs := root.subs
+	subs.mu.Lock()
+	subs.byT[t] = sr
+	subs.mu.Unlock()
+	t.Cleanup(func() {
+		subs.mu.Lock()
+		delete(subs.byT, t)
+		subs.mu.Unlock()
+	})
+	return sr
+}
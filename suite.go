@@ -0,0 +1,98 @@
+package got
+
+import (
+	"testing"
+)
+
+// CaseSuite groups table-driven test cases that share an expensive fixture.
+// The Before function runs once before all cases, and the After function is
+// registered as a cleanup so it runs when the test ends, even on failure.
+//
+// Example:
+//
+//	suite := got.NewSuite("user repository").
+//		Before(func() error { return migrate(db) }).
+//		After(func() { db.Close() }).
+//		Add(
+//			got.NewCase("find existing", 1, "alice", false, nil),
+//			got.NewCase("find missing", 2, nil, true, ErrNotFound),
+//		)
+//	r.RunSuite(suite, func(c got.Case, tt *testing.T) {
+//		// each case can rely on the migrated schema
+//	})
+type CaseSuite struct {
+	name   string
+	before func() error
+	after  func()
+	cases  []Case
+}
+
+// NewSuite creates a new, empty case suite with the given name.
+//
+// Parameters:
+//   - name: A descriptive name for the suite
+//
+// Returns:
+//   - *CaseSuite: A new suite for method chaining
+func NewSuite(name string) *CaseSuite {
+	return &CaseSuite{name: name}
+}
+
+// Name returns the name of the suite.
+func (s *CaseSuite) Name() string {
+	return s.name
+}
+
+// Before sets the setup function that runs once before all cases.
+// If it returns an error, the test is stopped and no case is run.
+func (s *CaseSuite) Before(fn func() error) *CaseSuite {
+	s.before = fn
+	return s
+}
+
+// After sets the teardown function that runs once when the test ends.
+func (s *CaseSuite) After(fn func()) *CaseSuite {
+	s.after = fn
+	return s
+}
+
+// Add appends cases to the suite and returns the suite for chaining.
+func (s *CaseSuite) Add(cases ...Case) *CaseSuite {
+	s.cases = append(s.cases, cases...)
+	return s
+}
+
+// Cases returns the cases of the suite.
+func (s *CaseSuite) Cases() []Case {
+	return s.cases
+}
+
+// RunSuite runs all cases of the suite like Cases, wrapped by the suite's
+// Before and After functions. Before runs once before the first case and
+// After is registered with Cleanup so it runs after all cases have finished.
+//
+// Parameters:
+//   - s: The suite to run
+//   - f: The test function that will be executed for each case
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.RunSuite(suite, func(c got.Case, tt *testing.T) {
+//		got, err := repo.Find(c.Input().(int))
+//		r.AssertEqual(c.Want(), got)
+//	})
+func (r *R) RunSuite(s *CaseSuite, f func(c Case, tt *testing.T)) *R {
+	r.T.Helper()
+	r.Case("Suite: %s", s.name)
+	if s.after != nil {
+		r.T.Cleanup(s.after)
+	}
+	if s.before != nil {
+		r.AssertNoErrf(s.before(), "Suite %s setup", s.name)
+	}
+	r.Cases(s.cases, f)
+	return r
+}
@@ -0,0 +1,52 @@
+package got_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestRunSuite tests running cases with shared setup and teardown
+func TestRunSuite(t *testing.T) {
+	r := got.New(t, "Test RunSuite")
+
+	var events []string
+	r.Run("suite", func(tt *testing.T) {
+		rr := got.New(tt, "Suite Test")
+		suite := got.NewSuite("shared fixture").
+			Before(func() error {
+				events = append(events, "before")
+				return nil
+			}).
+			After(func() {
+				events = append(events, "after")
+			}).
+			Add(
+				got.NewCase("row1", 1, 1, false, nil),
+				got.NewCase("row2", 2, 2, false, nil),
+			)
+		rr.AssertEqual("shared fixture", suite.Name())
+		rr.AssertEqual(2, len(suite.Cases()))
+		rr.RunSuite(suite, func(c got.Case, tt *testing.T) {
+			events = append(events, c.Name())
+		})
+	})
+
+	r.Case("Verifying setup and teardown order")
+	r.AssertEqual([]string{"before", "row1", "row2", "after"}, events)
+
+	r.Case("Verifying failed setup stops the suite")
+	ran := false
+	failed := gottest.Probe(func(pr *got.R) {
+		suite := got.NewSuite("broken").
+			Before(func() error { return errors.New("migration failed") }).
+			Add(got.NewCase("row", nil, nil, false, nil))
+		pr.RunSuite(suite, func(c got.Case, tt *testing.T) {
+			ran = true
+		})
+	})
+	r.AssertTrue(failed, "Failed setup should fail the test")
+	r.AssertFalse(ran, "Cases should not run when setup fails")
+}
@@ -0,0 +1,71 @@
+package got
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// caseTiming is the duration of a subtest run through the runner.
+type caseTiming struct {
+	name     string
+	nested   bool // whether the subtest ran inside another one
+	duration time.Duration
+}
+
+// timingLog collects the durations of the subtests of a runner.
+type timingLog struct {
+	mu      sync.Mutex
+	entries []caseTiming
+}
+
+// recordTiming adds the duration of the named subtest to the runner's timings.
+func (r *R) recordTiming(name string, nested bool, d time.Duration) {
+	log := r.root().timings
+	log.mu.Lock()
+	log.entries = append(log.entries, caseTiming{name: name, nested: nested, duration: d})
+	log.mu.Unlock()
+}
+
+// TimingReport logs the duration of every subtest run through Run, Caser,
+// Cases and the other case runners, slowest first, followed by the total of
+// the outermost subtests. The time of nested subtests is already included in
+// the time of the subtests they ran in.
+//
+// Returns:
+//   - *R: The runner instance for method chaining
+//
+// Example:
+//
+//	r.Cases(cases, func(c got.Case, tt *testing.T) { ... })
+//	r.TimingReport()
+func (r *R) TimingReport() *R {
+	r.T.Helper()
+	log := r.root().timings
+	log.mu.Lock()
+	entries := slices.Clone(log.entries)
+	log.mu.Unlock()
+
+	var total time.Duration
+	for _, e := range entries {
+		if !e.nested {
+			total += e.duration
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b caseTiming) int { return cmp.Compare(b.duration, a.duration) })
+
+	var sb strings.Builder
+	sb.WriteString("Timing report:\n")
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	for _, e := range entries {
+		fmt.Fprintf(tw, "  %v\t%s\n", e.duration.Round(time.Microsecond), e.name)
+	}
+	fmt.Fprintf(tw, "  %v\ttotal (%d cases)\n", total.Round(time.Microsecond), len(entries))
+	tw.Flush()
+	r.Log(strings.TrimSuffix(sb.String(), "\n"))
+	return r
+}
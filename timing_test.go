@@ -0,0 +1,37 @@
+package got_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go4x/got"
+)
+
+// TestTimingReport tests reporting subtest durations
+func TestTimingReport(t *testing.T) {
+	var out bytes.Buffer
+	r := got.New(t, "Test TimingReport", got.WithReporter(&out))
+
+	r.Caser("fast", func(tt *testing.T) {})
+	r.Caser("slow", func(tt *testing.T) {
+		r.Caser("inner", func(tt *testing.T) { time.Sleep(20 * time.Millisecond) })
+	})
+	out.Reset()
+	r.TimingReport()
+
+	report := out.String()
+	r.AssertContains(report, "total (3 cases)")
+	for _, line := range strings.Split(report, "\n") {
+		if strings.Contains(line, "total") {
+			total, err := time.ParseDuration(strings.Fields(line)[0])
+			r.AssertNoErr(err)
+			r.AssertTrue(total < 40*time.Millisecond, "nested time should not be counted twice:\n"+report)
+		}
+	}
+	slow := strings.Index(report, "TestTimingReport/slow\n")
+	inner := strings.Index(report, "TestTimingReport/inner\n")
+	fast := strings.Index(report, "TestTimingReport/fast\n")
+	r.AssertTrue(slow >= 0 && inner > slow && fast > inner, "cases should be listed slowest first:\n"+report)
+}
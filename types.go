@@ -0,0 +1,135 @@
+package got
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AssertImplementsAll asserts that value implements every interface given as
+// a nil pointer to the interface type, such as (*io.Reader)(nil). On failure,
+// it lists the interfaces that are not implemented along with their missing
+// methods.
+//
+// Example:
+//
+//	r.AssertImplementsAll(&MyDriver{},
+//		(*driver.Driver)(nil),
+//		(*driver.DriverContext)(nil),
+//	)
+func (r *R) AssertImplementsAll(value any, ifacePtrs ...any) *R {
+	r.T.Helper()
+	vt := reflect.TypeOf(value)
+	if vt == nil {
+		r.Fail("Expected a non-nil value to check interfaces against")
+		return r
+	}
+	var missing []string
+	for _, p := range ifacePtrs {
+		pt := reflect.TypeOf(p)
+		if pt == nil || pt.Kind() != reflect.Pointer || pt.Elem().Kind() != reflect.Interface {
+			r.Fail("Expected a pointer to an interface type, got %T", p)
+			return r
+		}
+		it := pt.Elem()
+		if !vt.Implements(it) {
+			missing = append(missing, fmt.Sprintf("%s (missing %s)", it, strings.Join(missingMethods(vt, it), ", ")))
+		}
+	}
+	r.report(check{
+		ok:      len(missing) == 0,
+		pass:    fmt.Sprintf("%s implements all %d interfaces", vt, len(ifacePtrs)),
+		fail:    fmt.Sprintf("Expected %s to implement all interfaces, but it does not implement: %s", vt, strings.Join(missing, "; ")),
+		notPass: fmt.Sprintf("%s does not implement all interfaces", vt),
+		notFail: fmt.Sprintf("Expected %s not to implement all %d interfaces", vt, len(ifacePtrs)),
+	}, nil)
+	return r
+}
+
+// missingMethods returns the methods of interface it that t lacks or
+// implements with a different signature.
+func missingMethods(t, it reflect.Type) []string {
+	var missing []string
+	for i := 0; i < it.NumMethod(); i++ {
+		im := it.Method(i)
+		m, ok := t.MethodByName(im.Name)
+		switch {
+		case !ok:
+			missing = append(missing, im.Name)
+		case !sameMethodType(m.Type, im.Type):
+			missing = append(missing, fmt.Sprintf("%s (has %s, wants %s)", im.Name, m.Type, im.Type))
+		}
+	}
+	return missing
+}
+
+// sameMethodType compares a method type, whose first input is the receiver,
+// with an interface method type, which has no receiver.
+func sameMethodType(m, im reflect.Type) bool {
+	if m.NumIn()-1 != im.NumIn() || m.NumOut() != im.NumOut() || m.IsVariadic() != im.IsVariadic() {
+		return false
+	}
+	for i := 0; i < im.NumIn(); i++ {
+		if m.In(i+1) != im.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < im.NumOut(); i++ {
+		if m.Out(i) != im.Out(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// AssertSame asserts that a and b are references of the same type to the same
+// object: pointers, maps, channels or functions with the same address, or
+// slices sharing the same backing array start and length. Unlike AssertEqual,
+// two distinct pointers to equal values are not the same.
+//
+// Example:
+//
+//	r.AssertSame(cache.Get("k"), cache.Get("k"))
+func (r *R) AssertSame(a, b any, msg ...string) *R {
+	r.T.Helper()
+	pa, oka := referenceAddr(a)
+	pb, okb := referenceAddr(b)
+	if !oka || !okb {
+		r.Fail("Expected two pointers or other reference values, got %T and %T", a, b)
+		return r
+	}
+	same := reflect.TypeOf(a) == reflect.TypeOf(b) && pa == pb &&
+		(reflect.ValueOf(a).Kind() != reflect.Slice || reflect.ValueOf(a).Len() == reflect.ValueOf(b).Len())
+	r.report(check{
+		ok:      same,
+		pass:    fmt.Sprintf("Both values point to the same %T at %#x", a, pa),
+		fail:    fmt.Sprintf("Expected the same reference, but %T points to %#x and %T points to %#x", a, pa, b, pb),
+		notPass: fmt.Sprintf("Values are distinct references (%#x and %#x)", pa, pb),
+		notFail: fmt.Sprintf("Expected distinct references, but both point to the same %T at %#x", a, pa),
+	}, msg)
+	return r
+}
+
+// AssertNotSame asserts that a and b are not the same reference; see
+// AssertSame. Use it to check that a copy was made instead of sharing data.
+//
+// Example:
+//
+//	r.AssertNotSame(original, clone)
+func (r *R) AssertNotSame(a, b any, msg ...string) *R {
+	r.T.Helper()
+	r.Not().AssertSame(a, b, msg...)
+	return r
+}
+
+// referenceAddr returns the address held by a reference value such as a
+// pointer, map, slice, channel or function. The second result is false if v
+// is not a reference value.
+func referenceAddr(v any) (uintptr, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return rv.Pointer(), true
+	}
+	return 0, false
+}
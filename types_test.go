@@ -0,0 +1,59 @@
+package got_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// buffer implements io.Reader and fmt.Stringer but not io.Writer
+type buffer struct{}
+
+func (buffer) Read(p []byte) (int, error) { return 0, io.EOF }
+func (buffer) String() string             { return "buffer" }
+func (buffer) Close()                     {}
+
+// TestAssertImplementsAll tests interface implementation checks
+func TestAssertImplementsAll(t *testing.T) {
+	r := got.New(t, "Test AssertImplementsAll")
+
+	r.Case("Testing implemented interfaces")
+	r.AssertImplementsAll(buffer{}, (*io.Reader)(nil), (*fmt.Stringer)(nil))
+	r.AssertImplementsAll(&buffer{}, (*io.Reader)(nil))
+
+	r.Case("Testing missing interfaces")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertImplementsAll(buffer{}, (*io.Reader)(nil), (*io.Writer)(nil)) }), "missing io.Writer should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertImplementsAll(buffer{}, (*io.Closer)(nil)) }), "wrong Close signature should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertImplementsAll(buffer{}, io.Reader(nil)) }), "non-pointer interface should fail")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertImplementsAll(nil, (*io.Reader)(nil)) }), "nil value should fail")
+}
+
+// TestAssertSame tests pointer identity assertions
+func TestAssertSame(t *testing.T) {
+	r := got.New(t, "Test AssertSame")
+	type point struct{ X, Y int }
+	p := &point{1, 2}
+	q := &point{1, 2}
+	s := []int{1, 2, 3}
+	m := map[string]int{"a": 1}
+
+	r.Case("Testing same references")
+	r.AssertSame(p, p)
+	r.AssertSame(s, s)
+	r.AssertSame(m, m)
+
+	r.Case("Testing distinct references")
+	r.AssertNotSame(p, q)
+	r.AssertNotSame(s, append([]int(nil), s...))
+	r.AssertNotSame(s, s[:2])
+	r.AssertNotSame(m, map[string]int{"a": 1})
+	r.AssertEqual(p, q, "distinct pointers should still be deeply equal")
+
+	r.Case("Testing failures")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSame(p, q) }), "distinct pointers should fail AssertSame")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertNotSame(p, p) }), "same pointer should fail AssertNotSame")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.AssertSame(1, 1) }), "non-reference values should fail")
+}
@@ -0,0 +1,38 @@
+package got
+
+// ExpectFailure marks the next assertion as a known failure, such as one
+// caused by a bug that has been reported but not fixed yet. The outcome of
+// that assertion, and only that one, is inverted: if it fails, the failure is
+// logged as a known issue together with reason and the test keeps passing; if
+// it unexpectedly passes, the test fails with a message asking to remove the
+// marker, so that fixed bugs do not go unnoticed.
+//
+// The marker applies to the next assertion reported through Pass or Fail on
+// the runner or any of its Not views. Calling ExpectFailure again before an
+// assertion replaces the reason.
+//
+// Parameters:
+//   - reason: A description of the known issue, such as a bug tracker reference
+//
+// Returns:
+//   - *R: The runner, for chaining the assertion to invert
+//
+// Example:
+//
+//	r.ExpectFailure("issue #42: rounding error").AssertEqual(0.3, 0.1+0.2)
+func (r *R) ExpectFailure(reason string) *R {
+	r.root().xfail = &reason
+	return r
+}
+
+// takeExpectedFailure clears the marker set by ExpectFailure, returning its
+// reason and whether it was set.
+func (r *R) takeExpectedFailure() (string, bool) {
+	root := r.root()
+	if root.xfail == nil {
+		return "", false
+	}
+	reason := *root.xfail
+	root.xfail = nil
+	return reason, true
+}
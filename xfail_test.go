@@ -0,0 +1,65 @@
+package got_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestExpectFailure tests marking assertions as known failures
+func TestExpectFailure(t *testing.T) {
+	r := got.New(t, "Test ExpectFailure")
+
+	r.Case("Testing an expected failure keeps the test passing")
+	r.ExpectFailure("issue #42").AssertEqual(1, 2)
+	r.AssertFalse(gottest.Probe(func(pr *got.R) { pr.ExpectFailure("known").Require(false, "broken") }),
+		"expected failure should pass")
+
+	r.Case("Testing an unexpected pass fails the test")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { pr.ExpectFailure("fixed").AssertEqual(1, 1) }),
+		"unexpected pass should fail")
+
+	r.Case("Testing only the next assertion is inverted")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) {
+		pr.ExpectFailure("known").AssertEqual(1, 2)
+		pr.AssertEqual(1, 2)
+	}), "second assertion should fail normally")
+	r.AssertFalse(gottest.Probe(func(pr *got.R) {
+		pr.ExpectFailure("known").AssertEqual(1, 2)
+		pr.AssertEqual(1, 1)
+	}), "second assertion should pass normally")
+
+	r.Case("Testing the marker applies through Not views")
+	r.AssertFalse(gottest.Probe(func(pr *got.R) { pr.ExpectFailure("known").Not().AssertEqual(1, 1) }),
+		"negated expected failure should pass")
+
+	r.Case("Testing expected failures do not stop the test")
+	boom := errors.New("boom")
+	stopping := map[string]func(pr *got.R){
+		"FailNow":      func(pr *got.R) { pr.FailNow(false, "broken") },
+		"AssertNoErr":  func(pr *got.R) { pr.AssertNoErr(boom) },
+		"AssertNoErrf": func(pr *got.R) { pr.AssertNoErrf(boom, "open %s", "config") },
+		"AssertErr":    func(pr *got.R) { pr.AssertErr(nil) },
+		"AssertErrf":   func(pr *got.R) { pr.AssertErrf(nil, "open %s", "config") },
+		"MustNoErrors": func(pr *got.R) { pr.MustNoErrors(nil, boom) },
+	}
+	for name, assert := range stopping {
+		continued := false
+		failed, out := gottest.Detached(func(pr *got.R) {
+			assert(pr.ExpectFailure("known"))
+			continued = true
+		})
+		r.AssertFalse(failed, name+": expected failure should pass:\n"+out)
+		r.AssertTrue(continued, name+": expected failure should not stop the test")
+		r.AssertTrue(gottest.Probe(func(pr *got.R) { assert(pr) }), name+": failure should still fail")
+	}
+
+	r.Case("Testing the known issue is logged")
+	_, out := gottest.Detached(func(pr *got.R) { pr.ExpectFailure("issue #42").AssertEqual(1, 2) })
+	r.AssertTrue(strings.Contains(out, "[PASS] Known issue (issue #42)"), out)
+	_, out = gottest.Detached(func(pr *got.R) { pr.ExpectFailure("issue #7").AssertEqual(1, 1) })
+	r.AssertTrue(strings.Contains(out, "[FAIL] Expected failure did not occur (issue #7)"), out)
+}
@@ -0,0 +1,73 @@
+package yamlt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/go4x/got"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlCase is the file representation of a test case.
+type yamlCase struct {
+	Name    string    `yaml:"name"`
+	Input   yaml.Node `yaml:"input"`
+	Want    yaml.Node `yaml:"want"`
+	WantErr bool      `yaml:"wantErr"`
+	Err     string    `yaml:"err"`
+}
+
+// LoadCases reads table-driven test cases from a YAML file. It is the YAML
+// sibling of got.LoadCasesJSON: the file must contain a sequence of mappings
+// with the keys "name", "input", "want", "wantErr" and "err", and input and
+// want are decoded into new values of inputType and wantType (or into the
+// default YAML representation when the type is nil).
+func LoadCases(path string, inputType, wantType reflect.Type) ([]got.Case, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cases file: %w", err)
+	}
+	var records []yamlCase
+	if err := yaml.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode cases file %s: %w", path, err)
+	}
+	cases := make([]got.Case, 0, len(records))
+	for i, rec := range records {
+		input, err := decodeNode(&rec.Input, inputType)
+		if err != nil {
+			return nil, fmt.Errorf("case %d (%s): invalid input: %w", i, rec.Name, err)
+		}
+		want, err := decodeNode(&rec.Want, wantType)
+		if err != nil {
+			return nil, fmt.Errorf("case %d (%s): invalid want: %w", i, rec.Name, err)
+		}
+		var caseErr error
+		if rec.Err != "" {
+			caseErr = errors.New(rec.Err)
+		}
+		cases = append(cases, got.NewCase(rec.Name, input, want, rec.WantErr, caseErr))
+	}
+	return cases, nil
+}
+
+// decodeNode decodes node into a new value of type typ, or into an any if typ
+// is nil. A missing node yields the zero value of typ.
+func decodeNode(node *yaml.Node, typ reflect.Type) (any, error) {
+	if typ == nil {
+		var v any
+		if node.IsZero() {
+			return nil, nil
+		}
+		err := node.Decode(&v)
+		return v, err
+	}
+	ptr := reflect.New(typ)
+	if !node.IsZero() {
+		if err := node.Decode(ptr.Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return ptr.Elem().Interface(), nil
+}
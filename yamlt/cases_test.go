@@ -0,0 +1,47 @@
+package yamlt
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"testing"
+
+	"github.com/go4x/got"
+)
+
+// TestLoadCases tests loading cases from a YAML file
+func TestLoadCases(t *testing.T) {
+	r := got.New(t, "Test LoadCases")
+
+	r.Case("Testing typed cases")
+	path := r.TempFile("cases-*.yaml", []byte(`
+- name: add
+  input: [1, 2]
+  want: 3
+- name: overflow
+  input: [1]
+  wantErr: true
+  err: need two operands
+`))
+	cases, err := LoadCases(path, reflect.TypeOf([]int{}), reflect.TypeOf(0))
+	r.AssertNoErr(err)
+	r.AssertEqual(2, len(cases))
+	r.AssertEqual("add", cases[0].Name())
+	r.AssertEqual([]int{1, 2}, cases[0].Input())
+	r.AssertEqual(3, cases[0].Want())
+	r.AssertNil(cases[0].Err())
+	r.AssertEqual(0, cases[1].Want())
+	r.AssertTrue(cases[1].WantErr())
+	r.AssertEqual("need two operands", cases[1].Err().Error())
+
+	r.Case("Testing untyped cases")
+	cases, err = LoadCases(path, nil, nil)
+	r.AssertNoErr(err)
+	r.AssertEqual([]any{1, 2}, cases[0].Input())
+
+	r.Case("Testing invalid files")
+	_, err = LoadCases(path+".missing", nil, nil)
+	r.AssertTrue(errors.Is(err, fs.ErrNotExist), "a missing file should wrap fs.ErrNotExist")
+	_, err = LoadCases(path, reflect.TypeOf(""), nil)
+	r.AssertErr(err)
+}
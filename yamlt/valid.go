@@ -0,0 +1,40 @@
+package yamlt
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/go4x/got"
+	"gopkg.in/yaml.v3"
+)
+
+// AssertValidYAML asserts through r that s is well-formed YAML. Every
+// document of a multi-document stream is checked. On failure, the parse
+// error is reported; it includes the line where parsing failed. The check is
+// not inverted by got.R.Not.
+//
+// Example:
+//
+//	yamlt.AssertValidYAML(r, string(manifest))
+func AssertValidYAML(r *got.R, s string, msg ...string) *got.R {
+	r.T.Helper()
+	dec := yaml.NewDecoder(strings.NewReader(s))
+	for {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			message := "Expected valid YAML, but " + err.Error()
+			if len(msg) > 0 {
+				message = msg[0]
+			}
+			r.Fail(message)
+			return r
+		}
+	}
+	r.Pass("String is valid YAML")
+	return r
+}
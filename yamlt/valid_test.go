@@ -0,0 +1,24 @@
+package yamlt
+
+import (
+	"testing"
+
+	"github.com/go4x/got"
+	"github.com/go4x/got/internal/gottest"
+)
+
+// TestAssertValidYAML tests checking that strings are well-formed YAML
+func TestAssertValidYAML(t *testing.T) {
+	r := got.New(t, "Test AssertValidYAML")
+
+	r.Case("Testing valid YAML")
+	AssertValidYAML(r, "name: alice\ntags: [a, b]\n")
+	AssertValidYAML(r, "a: 1\n---\nb: 2\n")
+	AssertValidYAML(r, "")
+
+	r.Case("Testing invalid YAML")
+	failed, out := gottest.Detached(func(pr *got.R) { AssertValidYAML(pr, "name: alice\n  age: 3\n") })
+	r.AssertTrue(failed, "malformed YAML should fail")
+	r.AssertContains(out, "line 2")
+	r.AssertTrue(gottest.Probe(func(pr *got.R) { AssertValidYAML(pr, "a: 1\n---\nb: [\n") }), "a malformed later document should fail")
+}